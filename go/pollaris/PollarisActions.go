@@ -0,0 +1,172 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8types/go/types/l8web"
+	"google.golang.org/protobuf/proto"
+)
+
+// doAction handles POST requests that carry an action message rather than
+// a L8Pollaris to create. Returns the response and true if the element was
+// an action, or nil and false if the request should be handled as a regular POST.
+// Actions the caller is not permitted to perform return the denial error.
+func (this *PollarisService) doAction(pb ifs.IElements, vnic ifs.IVNic) (ifs.IElements, bool) {
+	resp, ok := this.doRead(pb)
+	if ok {
+		return resp, true
+	}
+	err := this.authorizeAction(pb)
+	if err != nil {
+		return object.New(err, &l8web.L8Empty{}), true
+	}
+	switch request := pb.Element().(type) {
+	case *l8tpollaris.L8PollarisRevisions:
+		return object.New(nil, &l8tpollaris.L8PollarisRevisions{Name: request.Name,
+			Revisions: this.pollarisCenter.Revisions(request.Name)}), true
+	case *l8tpollaris.L8PollarisExplain:
+		return object.New(nil, this.pollarisCenter.Explain(request)), true
+	case *l8tpollaris.L8PollarisRollback:
+		vnic.Resources().Logger().Info("Rolling back l8Pollaris ", request.Name, " to revision ", request.Revision)
+		err := this.audited(pb, "ROLLBACK", request.Name, func() error {
+			return this.pollarisCenter.Rollback(request.Name, request.Revision, pb.Notification())
+		})
+		return object.New(err, &l8web.L8Empty{}), true
+	case *l8tpollaris.L8PollarisExport:
+		bundle := this.pollarisCenter.Export(request.Groups, request.Vendors)
+		bundle.List = this.readable(pb, bundle.List)
+		if this.authorize(pb, PermissionRead, nil) != nil {
+			bundle.Groups = []*l8tpollaris.L8PollarisGroupDefinition{}
+		}
+		return object.New(nil, &l8tpollaris.L8PollarisExport{Groups: request.Groups, Vendors: request.Vendors,
+			Bundle: bundle}), true
+	case *l8tpollaris.L8PollarisImport:
+		vnic.Resources().Logger().Info("Importing l8Pollaris bundle")
+		before, groups := this.importedDefinitions(pb, request.Bundle)
+		results, err := this.pollarisCenter.Import(request.Bundle, request.Policy, pb.Notification())
+		this.recordImport(pb, before, groups, results)
+		return object.New(err, &l8tpollaris.L8PollarisImport{Policy: request.Policy, Results: results}), true
+	case *l8tpollaris.L8PollarisSnapshot:
+		return object.New(nil, &l8tpollaris.L8PollarisSnapshot{List: this.pollarisCenter.Snapshot(),
+			Groups: this.pollarisCenter.GroupSnapshot()}), true
+	case *l8tpollaris.L8PollarisGroupDefinition:
+		vnic.Resources().Logger().Info("Defining l8Pollaris group ", request.Group)
+		err := this.auditedGroup(pb, "DEFINE", request.Group, func() error {
+			return this.pollarisCenter.DefineGroup(request, pb.Notification())
+		})
+		return object.New(err, &l8web.L8Empty{}), true
+	case *l8tpollaris.L8PollarisGroupTree:
+		return object.New(nil, this.readableTree(pb, this.pollarisCenter.GroupTree(request.Group))), true
+	case *l8tpollaris.L8PollarisDryRun:
+		dryRun, err := this.pollarisCenter.DryRunOf(request)
+		if err != nil {
+			return object.New(err, &l8tpollaris.L8PollarisDryRun{}), true
+		}
+		return object.New(nil, dryRun), true
+	case *l8tpollaris.L8PollarisDiff:
+		diff, err := this.pollarisCenter.DiffOf(request)
+		if err != nil {
+			return object.New(err, &l8tpollaris.L8PollarisDiff{}), true
+		}
+		return object.New(nil, diff), true
+	case *l8tpollaris.L8PollarisSubscription:
+		if request.Unsubscribe {
+			vnic.Resources().Logger().Info("Unsubscribing ", request.ServiceName, " from l8Pollaris events")
+			this.pollarisCenter.UnsubscribeService(request.ServiceName, request.ServiceArea)
+			return object.New(nil, &l8web.L8Empty{}), true
+		}
+		vnic.Resources().Logger().Info("Subscribing ", request.ServiceName, " to l8Pollaris events")
+		err := this.pollarisCenter.SubscribeService(request)
+		return object.New(err, &l8web.L8Empty{}), true
+	}
+	return nil, false
+}
+
+// doRead handles the read requests that resolve pollarises or polls rather
+// than query L8Pollaris: the key lookup, the group resolution, the single
+// poll fetch, the group catalog, the reverse index and the key table. They
+// are served on GET, and on POST for the clients of the action messages. The results are copies, so the caller can modify them
+// without affecting the cached definitions, and are authorized for reading.
+// Returns the response and true if the element was a read request, or nil
+// and false otherwise.
+func (this *PollarisService) doRead(pb ifs.IElements) (ifs.IElements, bool) {
+	switch request := pb.Element().(type) {
+	case *l8tpollaris.L8PollarisLookup:
+		result := this.pollarisCenter.PollarisByKey(request.Name, request.Vendor, request.Series, request.Family,
+			request.Software, request.Hardware, request.Version)
+		if result == nil {
+			return object.New(errors.New("Cannot find Pollaris for key "+keyOf(request.Name, request.Vendor,
+				request.Series, request.Family, request.Software, request.Hardware, request.Version)),
+				&l8tpollaris.L8PollarisLookup{}), true
+		}
+		err := this.authorize(pb, PermissionRead, result)
+		if err != nil {
+			return object.New(err, &l8tpollaris.L8PollarisLookup{}), true
+		}
+		response := proto.Clone(request).(*l8tpollaris.L8PollarisLookup)
+		response.Result = proto.Clone(result).(*l8tpollaris.L8Pollaris)
+		return object.New(nil, response), true
+	case *l8tpollaris.L8PollarisGroup:
+		response := proto.Clone(request).(*l8tpollaris.L8PollarisGroup)
+		for _, l8pollaris := range this.readable(pb, this.pollarisCenter.PollsByGroup(request.Group, request.Vendor,
+			request.Series, request.Family, request.Software, request.Hardware, request.Version)) {
+			response.List = append(response.List, proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris))
+		}
+		return object.New(nil, response), true
+	case *l8tpollaris.L8PollarisPoll:
+		err := this.authorizeName(pb, PermissionRead, request.PollarisName)
+		if err != nil {
+			return object.New(err, &l8tpollaris.L8PollarisPoll{}), true
+		}
+		poll := this.pollarisCenter.Poll(request.PollarisName, request.PollName)
+		if poll == nil {
+			return object.New(errors.New("Cannot find poll "+request.PollName+" in Pollaris "+request.PollarisName),
+				&l8tpollaris.L8PollarisPoll{}), true
+		}
+		return object.New(nil, &l8tpollaris.L8PollarisPoll{PollarisName: request.PollarisName,
+			PollName: request.PollName, Poll: proto.Clone(poll).(*l8tpollaris.L8Poll)}), true
+	case *l8tpollaris.L8PollarisGroups:
+		err := this.authorize(pb, PermissionRead, nil)
+		if err != nil {
+			return object.New(err, &l8tpollaris.L8PollarisGroups{}), true
+		}
+		response := &l8tpollaris.L8PollarisGroups{}
+		for _, info := range this.pollarisCenter.Groups() {
+			response.Groups = append(response.Groups, proto.Clone(info).(*l8tpollaris.L8PGroupInfo))
+		}
+		return object.New(nil, response), true
+	case *l8tpollaris.L8PollarisIndex:
+		response := &l8tpollaris.L8PollarisIndex{Name: request.Name, Entries: make([]*l8tpollaris.L8PIndexEntry, 0)}
+		for _, entry := range this.pollarisCenter.Index(request.Name) {
+			if this.readableName(pb, entry.Name) {
+				response.Entries = append(response.Entries, proto.Clone(entry).(*l8tpollaris.L8PIndexEntry))
+			}
+		}
+		return object.New(nil, response), true
+	case *l8tpollaris.L8PollarisKeys:
+		response := &l8tpollaris.L8PollarisKeys{Keys: make([]*l8tpollaris.L8PKeyEntry, 0)}
+		for _, entry := range this.pollarisCenter.Keys() {
+			if this.readableName(pb, entry.Name) {
+				response.Keys = append(response.Keys, proto.Clone(entry).(*l8tpollaris.L8PKeyEntry))
+			}
+		}
+		return object.New(nil, response), true
+	}
+	return nil, false
+}
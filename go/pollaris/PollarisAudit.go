@@ -0,0 +1,148 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"strconv"
+
+	"github.com/saichler/l8pollaris/go/pollaris/audit"
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/proto"
+)
+
+// auditObjectType is the object type of the pollaris audit records.
+const auditObjectType = "L8Pollaris"
+
+// auditGroupType is the object type of the group definition audit records.
+const auditGroupType = "L8PollarisGroupDefinition"
+
+// auditId returns the audited object id of the named pollaris, which is
+// prefixed by the service area in the tenant areas.
+func (this *PollarisService) auditId(name string) string {
+	if this.serviceArea == ServiceArea {
+		return name
+	}
+	return strconv.Itoa(int(this.serviceArea)) + "/" + name
+}
+
+// definitionOf returns a copy of the stored definition of the named pollaris,
+// or nil if it does not exist.
+func (this *PollarisService) definitionOf(name string) *l8tpollaris.L8Pollaris {
+	definition := this.pollarisCenter.Definition(name)
+	if definition == nil {
+		return nil
+	}
+	return proto.Clone(definition).(*l8tpollaris.L8Pollaris)
+}
+
+// audited runs a change of the named pollaris and records it in the audit
+// trail, with the request caller as the actor. The change is recorded even
+// if it returns an error, as long as the definition was changed. Changes
+// received as notifications from Pollaris peers are recorded by the instance
+// where they originated.
+func (this *PollarisService) audited(pb ifs.IElements, operation, name string, change func() error) error {
+	if !audit.Enabled() || this.fromPeer(pb) {
+		return change()
+	}
+	before := this.definitionOf(name)
+	err := change()
+	this.record(pb, operation, name, before)
+	return err
+}
+
+// record adds the change of the named pollaris from before to its current
+// definition to the audit trail, with an anonymous caller as audit.Anonymous.
+func (this *PollarisService) record(pb ifs.IElements, operation, name string, before *l8tpollaris.L8Pollaris) {
+	err := audit.Record(audit.ActorOf(IdentityOf(pb)), operation, auditObjectType, this.auditId(name), before,
+		this.pollarisCenter.Definition(name))
+	if err != nil {
+		this.pollarisCenter.log.Error("Cannot audit ", operation, " of Pollaris ", name, ": ", err.Error())
+	}
+}
+
+// auditedGroup runs a change of the group definition and records it in the
+// audit trail, like audited.
+func (this *PollarisService) auditedGroup(pb ifs.IElements, operation, group string, change func() error) error {
+	if !audit.Enabled() || this.fromPeer(pb) {
+		return change()
+	}
+	before := this.pollarisCenter.GroupDefinition(group)
+	err := change()
+	this.recordGroup(pb, operation, group, before)
+	return err
+}
+
+// recordGroup adds the change of the group definition from before to its
+// current definition to the audit trail, like record.
+func (this *PollarisService) recordGroup(pb ifs.IElements, operation, group string, before *l8tpollaris.L8PollarisGroupDefinition) {
+	err := audit.Record(audit.ActorOf(IdentityOf(pb)), operation, auditGroupType, this.auditId(group), before,
+		this.pollarisCenter.GroupDefinition(group))
+	if err != nil {
+		this.pollarisCenter.log.Error("Cannot audit ", operation, " of group ", group, ": ", err.Error())
+	}
+}
+
+// importedDefinitions returns copies of the stored pollaris and group
+// definitions the bundle may replace, by name, when the import is audited.
+func (this *PollarisService) importedDefinitions(pb ifs.IElements, bundle *l8tpollaris.L8PollarisBundle) (map[string]*l8tpollaris.L8Pollaris,
+	map[string]*l8tpollaris.L8PollarisGroupDefinition) {
+	if !audit.Enabled() || this.fromPeer(pb) || bundle == nil {
+		return nil, nil
+	}
+	result := make(map[string]*l8tpollaris.L8Pollaris)
+	for _, l8pollaris := range bundle.List {
+		definition := this.definitionOf(l8pollaris.Name)
+		if definition != nil {
+			result[l8pollaris.Name] = definition
+		}
+	}
+	groups := make(map[string]*l8tpollaris.L8PollarisGroupDefinition)
+	for _, group := range bundle.Groups {
+		definition := this.pollarisCenter.GroupDefinition(group.Group)
+		if definition != nil {
+			groups[group.Group] = definition
+		}
+	}
+	return result, groups
+}
+
+// recordImport adds the pollarises and the group definitions stored by an
+// import to the audit trail.
+func (this *PollarisService) recordImport(pb ifs.IElements, before map[string]*l8tpollaris.L8Pollaris,
+	groups map[string]*l8tpollaris.L8PollarisGroupDefinition, results []*l8tpollaris.L8PImportResult) {
+	if before == nil {
+		return
+	}
+	for _, result := range results {
+		switch result.Status {
+		case l8tpollaris.L8PImportStatus_L8PImport_Added, l8tpollaris.L8PImportStatus_L8PImport_Overwritten,
+			l8tpollaris.L8PImportStatus_L8PImport_Renamed:
+			if result.Group != "" {
+				this.recordGroup(pb, "IMPORT", result.Group, groups[result.Group])
+				continue
+			}
+			this.record(pb, "IMPORT", result.StoredAs, before[result.StoredAs])
+		}
+	}
+}
+
+// authorizeAudit authorizes a query of the audit trail, which requires the
+// permission to read the whole Pollaris service. See audit.Authorize.
+func authorizeAudit(pb ifs.IElements) error {
+	if PollarisAuthorizer == nil {
+		return nil
+	}
+	return PollarisAuthorizer.Authorize(IdentityOf(pb), PermissionRead, nil)
+}
@@ -0,0 +1,426 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// Permission is an operation of the Pollaris service that can be granted to a role.
+type Permission int
+
+const (
+	// PermissionRead allows retrieving pollarises, their revisions, lookups and diffs
+	PermissionRead Permission = iota
+	// PermissionCreate allows adding new pollarises
+	PermissionCreate
+	// PermissionUpdate allows replacing, patching and rolling back pollarises
+	PermissionUpdate
+	// PermissionDelete allows removing pollarises
+	PermissionDelete
+)
+
+// String returns the name of the permission.
+func (this Permission) String() string {
+	switch this {
+	case PermissionRead:
+		return "read"
+	case PermissionCreate:
+		return "create"
+	case PermissionUpdate:
+		return "update"
+	case PermissionDelete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Authorizer decides whether a caller identity may perform an operation on a
+// pollaris. A nil pollaris stands for the whole service, such as a snapshot
+// of all the pollarises.
+type Authorizer interface {
+	// Authorize returns nil if the identity has the permission on the
+	// pollaris, or the denial error otherwise
+	Authorize(identity string, permission Permission, l8pollaris *l8tpollaris.L8Pollaris) error
+}
+
+// PollarisAuthorizer is the optional authorizer of the Pollaris service
+// operations. When nil, every operation is allowed. Notifications from
+// Pollaris peers, which are the replication of a change already authorized
+// on the instance where it originated, are not authorized again.
+var PollarisAuthorizer Authorizer
+
+// PollarisPeers reports whether the uuid is the source of a Pollaris service
+// instance, whose notifications and snapshot requests are trusted. When nil,
+// the local instance and the participants of the service area in the service
+// registry are trusted. A notification from any other source is authorized
+// like any other request, as the notification flag of a message is set by
+// its sender.
+var PollarisPeers func(uuid string) bool
+
+// Role is a named set of permissions, optionally scoped to the pollarises
+// of some groups and vendors.
+type Role struct {
+	// Name identifies the role
+	Name string
+	// Permissions are the operations the role allows
+	Permissions []Permission
+	// Groups scope the role to the pollarises in one of them, all when empty
+	Groups []string
+	// Vendors scope the role to the pollarises of one of them, all when empty
+	Vendors []string
+}
+
+// allows reports whether the role grants the permission on the pollaris.
+// A scoped role does not grant permissions on the whole service.
+func (this *Role) allows(permission Permission, l8pollaris *l8tpollaris.L8Pollaris) bool {
+	granted := false
+	for _, p := range this.Permissions {
+		if p == permission {
+			granted = true
+			break
+		}
+	}
+	if !granted {
+		return false
+	}
+	if l8pollaris == nil {
+		return len(this.Groups) == 0 && len(this.Vendors) == 0
+	}
+	return inGroups(l8pollaris, this.Groups) && ofVendors(l8pollaris, this.Vendors)
+}
+
+// RoleAuthorizer is an Authorizer granting permissions through the roles
+// bound to each identity. An identity without roles is denied everything.
+type RoleAuthorizer struct {
+	// roles are the defined roles by name
+	roles map[string]*Role
+	// bindings are the role names bound to each identity
+	bindings map[string][]string
+	// mtx protects roles and bindings
+	mtx *sync.RWMutex
+}
+
+// NewRoleAuthorizer creates a RoleAuthorizer without roles.
+func NewRoleAuthorizer() *RoleAuthorizer {
+	return &RoleAuthorizer{roles: make(map[string]*Role), bindings: make(map[string][]string),
+		mtx: &sync.RWMutex{}}
+}
+
+// AddRole adds, or replaces, a role.
+func (this *RoleAuthorizer) AddRole(role *Role) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.roles[role.Name] = role
+}
+
+// RemoveRole removes a role. Identities bound to it lose its permissions.
+func (this *RoleAuthorizer) RemoveRole(name string) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	delete(this.roles, name)
+}
+
+// Bind binds roles to an identity, in addition to the ones already bound.
+func (this *RoleAuthorizer) Bind(identity string, roleNames ...string) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	for _, name := range roleNames {
+		if !contains(this.bindings[identity], name) {
+			this.bindings[identity] = append(this.bindings[identity], name)
+		}
+	}
+}
+
+// Unbind removes all the roles bound to an identity.
+func (this *RoleAuthorizer) Unbind(identity string) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	delete(this.bindings, identity)
+}
+
+// Authorize grants the permission if one of the roles bound to the identity
+// allows it on the pollaris.
+func (this *RoleAuthorizer) Authorize(identity string, permission Permission, l8pollaris *l8tpollaris.L8Pollaris) error {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	for _, name := range this.bindings[identity] {
+		role, ok := this.roles[name]
+		if ok && role.allows(permission, l8pollaris) {
+			return nil
+		}
+	}
+	return denied(identity, permission, l8pollaris)
+}
+
+// denied returns the error of a denied operation.
+func denied(identity string, permission Permission, l8pollaris *l8tpollaris.L8Pollaris) error {
+	if identity == "" {
+		identity = "anonymous"
+	}
+	target := "the Pollaris service"
+	if l8pollaris != nil {
+		target = "Pollaris " + l8pollaris.Name
+	}
+	return errors.New("Identity " + identity + " is not permitted to " + permission.String() + " " + target)
+}
+
+// Caller is the sender of a request, as carried by the incoming message,
+// which implements it.
+type Caller interface {
+	// AAAId returns the authenticated identity of the sender
+	AAAId() string
+	// Source returns the uuid of the sender vnic
+	Source() string
+}
+
+// callerElements are the elements of a request with the sender of the
+// incoming message attached.
+type callerElements struct {
+	ifs.IElements
+	// caller is the sender of the request
+	caller Caller
+}
+
+// WithCaller attaches the sender of the incoming message to the elements of
+// the request, so the service can authorize it, see Dispatch. Requests
+// without a caller are anonymous and are not trusted as notifications.
+func WithCaller(pb ifs.IElements, caller Caller) ifs.IElements {
+	return &callerElements{IElements: pb, caller: caller}
+}
+
+// MessageHandler is implemented by the service handlers that need the sender
+// of the incoming message, such as the Pollaris and Targets services. The
+// service framework delivers a message to such a handler through Handle,
+// instead of the method of the message action, as the action methods do not
+// receive the message.
+type MessageHandler interface {
+	// Handle processes the request carried by the message
+	Handle(pb ifs.IElements, msg *ifs.Message, vnic ifs.IVNic) ifs.IElements
+}
+
+// Dispatch attaches the sender of the message to the request and calls the
+// handler method of the message action. It implements Handle for the
+// handlers of this module.
+func Dispatch(handler ifs.IServiceHandler, pb ifs.IElements, msg *ifs.Message, vnic ifs.IVNic) ifs.IElements {
+	if msg == nil {
+		return object.New(errors.New("Request does not contain a message"), nil)
+	}
+	pb = WithCaller(pb, msg)
+	switch msg.Action() {
+	case ifs.POST:
+		return handler.Post(pb, vnic)
+	case ifs.PUT:
+		return handler.Put(pb, vnic)
+	case ifs.PATCH:
+		return handler.Patch(pb, vnic)
+	case ifs.DELETE:
+		return handler.Delete(pb, vnic)
+	case ifs.GET:
+		return handler.Get(pb, vnic)
+	}
+	return object.New(errors.New("Unsupported action "+strconv.Itoa(int(msg.Action()))), nil)
+}
+
+// callerOf returns the sender attached to the request, or nil if the request
+// has none.
+func callerOf(pb ifs.IElements) Caller {
+	elements, ok := pb.(*callerElements)
+	if !ok {
+		return nil
+	}
+	return elements.caller
+}
+
+// IdentityOf returns the caller identity of a request, or an empty string
+// for an anonymous request.
+func IdentityOf(pb ifs.IElements) string {
+	caller := callerOf(pb)
+	if caller == nil {
+		return ""
+	}
+	return caller.AAAId()
+}
+
+// fromPeer reports whether the request is a notification sent by this or
+// another Pollaris service instance.
+func (this *PollarisService) fromPeer(pb ifs.IElements) bool {
+	return pb.Notification() && this.peerCaller(pb)
+}
+
+// peerCaller reports whether the request is sent by this or another Pollaris
+// service instance of the service area, see PollarisPeers.
+func (this *PollarisService) peerCaller(pb ifs.IElements) bool {
+	caller := callerOf(pb)
+	if caller == nil || caller.Source() == "" {
+		return false
+	}
+	if caller.Source() == this.pollarisCenter.localUuid() {
+		return true
+	}
+	if PollarisPeers != nil {
+		return PollarisPeers(caller.Source())
+	}
+	participants := this.pollarisCenter.resources.Services().GetParticipants(ServiceName, this.serviceArea)
+	return participants[caller.Source()]
+}
+
+// authorize checks the permission of the request caller on the pollaris.
+// Returns nil if there is no PollarisAuthorizer or the request is a
+// notification from a Pollaris peer.
+func (this *PollarisService) authorize(pb ifs.IElements, permission Permission, l8pollaris *l8tpollaris.L8Pollaris) error {
+	if PollarisAuthorizer == nil || this.fromPeer(pb) {
+		return nil
+	}
+	return PollarisAuthorizer.Authorize(IdentityOf(pb), permission, l8pollaris)
+}
+
+// authorizeName checks the permission of the request caller on the named
+// pollaris. A pollaris that does not exist is only in the scope of the roles
+// that are not scoped to groups or vendors.
+func (this *PollarisService) authorizeName(pb ifs.IElements, permission Permission, name string) error {
+	if PollarisAuthorizer == nil || this.fromPeer(pb) {
+		return nil
+	}
+	l8pollaris := this.pollarisCenter.resolveDefinition(name)
+	if l8pollaris == nil {
+		l8pollaris = &l8tpollaris.L8Pollaris{Name: name}
+	}
+	return this.authorize(pb, permission, l8pollaris)
+}
+
+// authorizeWrite checks the permission of the request caller to store the
+// pollaris: create for a new one and update for an existing one, where an
+// update must be permitted on both the stored and the new definition so a
+// pollaris cannot be moved out of the caller scope.
+func (this *PollarisService) authorizeWrite(pb ifs.IElements, l8pollaris *l8tpollaris.L8Pollaris) error {
+	if PollarisAuthorizer == nil || this.fromPeer(pb) {
+		return nil
+	}
+	existing := this.pollarisCenter.Definition(l8pollaris.Name)
+	if existing == nil {
+		return this.authorize(pb, PermissionCreate, l8pollaris)
+	}
+	err := this.authorize(pb, PermissionUpdate, existing)
+	if err != nil {
+		return err
+	}
+	return this.authorize(pb, PermissionUpdate, l8pollaris)
+}
+
+// readable returns the pollarises of the list the request caller may read.
+func (this *PollarisService) readable(pb ifs.IElements, list []*l8tpollaris.L8Pollaris) []*l8tpollaris.L8Pollaris {
+	if PollarisAuthorizer == nil || this.fromPeer(pb) {
+		return list
+	}
+	result := make([]*l8tpollaris.L8Pollaris, 0, len(list))
+	for _, l8pollaris := range list {
+		if this.authorize(pb, PermissionRead, l8pollaris) == nil {
+			result = append(result, l8pollaris)
+		}
+	}
+	return result
+}
+
+// readableTree removes from the membership tree the members the request
+// caller may not read, keeping the tree structure.
+func (this *PollarisService) readableTree(pb ifs.IElements, tree *l8tpollaris.L8PollarisGroupTree) *l8tpollaris.L8PollarisGroupTree {
+	if PollarisAuthorizer == nil || this.fromPeer(pb) {
+		return tree
+	}
+	members := make([]string, 0, len(tree.Members))
+	for _, name := range tree.Members {
+		if this.readableName(pb, name) {
+			members = append(members, name)
+		}
+	}
+	tree.Members = members
+	for _, included := range tree.Groups {
+		this.readableTree(pb, included)
+	}
+	return tree
+}
+
+// readableName reports whether the request caller may read the named
+// pollaris, which must exist.
+func (this *PollarisService) readableName(pb ifs.IElements, name string) bool {
+	if PollarisAuthorizer == nil || this.fromPeer(pb) {
+		return true
+	}
+	l8pollaris := this.pollarisCenter.resolveDefinition(name)
+	return l8pollaris != nil && this.authorize(pb, PermissionRead, l8pollaris) == nil
+}
+
+// authorizeAction checks the permission of the request caller on the action
+// message of a POST: read for the queries of a named pollaris and for the
+// base of an inline dry run pollaris, update for a rollback, create or
+// update for every pollaris of an imported bundle, update on the whole
+// service for a group definition or a bundle with group definitions, and
+// read on the whole service for a snapshot or an event subscription, except
+// for a snapshot requested by a Pollaris peer, see PollarisPeers.
+// Group trees and exports are authorized on their results instead, the
+// group definitions of an export requiring read on the whole service, and
+// the read requests, including the group catalog, indexes and key tables,
+// are authorized by doRead.
+// Returns nil for a request that is not an action.
+func (this *PollarisService) authorizeAction(pb ifs.IElements) error {
+	switch request := pb.Element().(type) {
+	case *l8tpollaris.L8PollarisRevisions:
+		return this.authorizeName(pb, PermissionRead, request.Name)
+	case *l8tpollaris.L8PollarisExplain:
+		return this.authorizeName(pb, PermissionRead, request.Name)
+	case *l8tpollaris.L8PollarisDryRun:
+		if request.Pollaris == nil {
+			return this.authorizeName(pb, PermissionRead, request.Name)
+		}
+		if request.Pollaris.Extends != "" {
+			return this.authorizeName(pb, PermissionRead, request.Pollaris.Extends)
+		}
+	case *l8tpollaris.L8PollarisDiff:
+		if request.Name != "" {
+			return this.authorizeName(pb, PermissionRead, request.Name)
+		}
+	case *l8tpollaris.L8PollarisRollback:
+		return this.authorizeName(pb, PermissionUpdate, request.Name)
+	case *l8tpollaris.L8PollarisImport:
+		if request.Bundle == nil {
+			return nil
+		}
+		for _, l8pollaris := range request.Bundle.List {
+			err := this.authorizeWrite(pb, l8pollaris)
+			if err != nil {
+				return err
+			}
+		}
+		if len(request.Bundle.Groups) > 0 {
+			return this.authorize(pb, PermissionUpdate, nil)
+		}
+	case *l8tpollaris.L8PollarisGroupDefinition:
+		return this.authorize(pb, PermissionUpdate, nil)
+	case *l8tpollaris.L8PollarisSnapshot:
+		if this.peerCaller(pb) {
+			return nil
+		}
+		return this.authorize(pb, PermissionRead, nil)
+	case *l8tpollaris.L8PollarisSubscription:
+		return this.authorize(pb, PermissionRead, nil)
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// BundleVersion is the bundle format version written by Export. Import
+// rejects bundles with a newer version.
+const BundleVersion = 1
+
+// renameSuffix is appended to the name of an imported pollaris that is
+// renamed because of a conflict.
+const renameSuffix = "-imported"
+
+// Export returns a bundle with copies of the pollaris definitions, sorted by
+// name, and of the definitions of the groups that include other groups,
+// sorted by group. When groups is not empty, only pollarises in any of the
+// groups, and only the definitions of these groups, are exported; when
+// vendors is not empty, only pollarises of any of the vendors
+// (case-insensitive) are exported.
+func (this *PollarisCenter) Export(groups, vendors []string) *l8tpollaris.L8PollarisBundle {
+	bundle := &l8tpollaris.L8PollarisBundle{Version: BundleVersion, Created: time.Now().Unix(),
+		List: make([]*l8tpollaris.L8Pollaris, 0), Groups: make([]*l8tpollaris.L8PollarisGroupDefinition, 0)}
+	for _, name := range this.AllNames() {
+		l8pollaris := this.Definition(name)
+		if l8pollaris == nil || !inGroups(l8pollaris, groups) || !ofVendors(l8pollaris, vendors) {
+			continue
+		}
+		bundle.List = append(bundle.List, proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris))
+	}
+	for _, definition := range this.GroupSnapshot() {
+		if len(definition.Includes) == 0 || (len(groups) > 0 && !contains(groups, definition.Group)) {
+			continue
+		}
+		bundle.Groups = append(bundle.Groups, definition)
+	}
+	return bundle
+}
+
+// Import stores the pollarises of the bundle and returns the outcome for each
+// of them, in bundle order. A pollaris identical to the existing one is left
+// unchanged; otherwise a name conflict is resolved by the policy: skip keeps
+// the existing pollaris, overwrite replaces it, and rename stores the imported
+// one under a free name. Pollarises in the bundle extending a renamed one are
+// updated to extend the new name.
+// The group definitions of the bundle are defined after the pollarises, and
+// their outcomes follow the ones of the pollarises, with Group set instead
+// of Name. A conflict with an existing definition that includes other groups
+// is resolved by the same policy, except that a group is never renamed, as
+// the pollarises refer to it by name, so rename keeps the existing one too.
+// Returns an error if the bundle is missing or has an unsupported version.
+func (this *PollarisCenter) Import(bundle *l8tpollaris.L8PollarisBundle, policy l8tpollaris.L8PConflictPolicy,
+	isNotification bool) ([]*l8tpollaris.L8PImportResult, error) {
+	if bundle == nil {
+		return nil, errors.New("Import does not contain a bundle")
+	}
+	if bundle.Version > BundleVersion {
+		return nil, errors.New("Unsupported bundle version " + strconv.Itoa(int(bundle.Version)))
+	}
+
+	taken := make(map[string]bool)
+	for _, name := range this.AllNames() {
+		taken[name] = true
+	}
+	for _, l8pollaris := range bundle.List {
+		taken[l8pollaris.Name] = true
+	}
+
+	results := make([]*l8tpollaris.L8PImportResult, 0, len(bundle.List))
+	renames := make(map[string]string)
+	for _, l8pollaris := range bundle.List {
+		result := &l8tpollaris.L8PImportResult{Name: l8pollaris.Name, StoredAs: l8pollaris.Name,
+			Status: l8tpollaris.L8PImportStatus_L8PImport_Added}
+		results = append(results, result)
+		existing := this.Definition(l8pollaris.Name)
+		if existing == nil {
+			continue
+		}
+		if sameDefinition(existing, l8pollaris) {
+			result.Status = l8tpollaris.L8PImportStatus_L8PImport_Unchanged
+			continue
+		}
+		switch policy {
+		case l8tpollaris.L8PConflictPolicy_L8PConflict_Overwrite:
+			result.Status = l8tpollaris.L8PImportStatus_L8PImport_Overwritten
+			result.Message = "Replaced the existing Pollaris"
+		case l8tpollaris.L8PConflictPolicy_L8PConflict_Rename:
+			result.StoredAs = freeName(l8pollaris.Name, taken)
+			result.Status = l8tpollaris.L8PImportStatus_L8PImport_Renamed
+			result.Message = "Pollaris " + l8pollaris.Name + " already exists"
+			renames[l8pollaris.Name] = result.StoredAs
+		default:
+			result.Status = l8tpollaris.L8PImportStatus_L8PImport_Skipped
+			result.Message = "Pollaris " + l8pollaris.Name + " already exists"
+		}
+	}
+
+	for i, l8pollaris := range bundle.List {
+		result := results[i]
+		if result.Status == l8tpollaris.L8PImportStatus_L8PImport_Skipped ||
+			result.Status == l8tpollaris.L8PImportStatus_L8PImport_Unchanged {
+			continue
+		}
+		imported := proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+		imported.Name = result.StoredAs
+		imported.Revision = 0
+		if renamed, ok := renames[imported.Extends]; ok {
+			imported.Extends = renamed
+		}
+		var err error
+		if result.Status == l8tpollaris.L8PImportStatus_L8PImport_Overwritten {
+			err = this.Put(imported, isNotification)
+		} else {
+			err = this.Post(imported, isNotification)
+		}
+		if err != nil {
+			result.Status = l8tpollaris.L8PImportStatus_L8PImport_Failed
+			result.Message = err.Error()
+		}
+	}
+
+	for _, definition := range bundle.Groups {
+		results = append(results, this.importGroup(definition, policy, isNotification))
+	}
+	return results, nil
+}
+
+// importGroup defines a group of an imported bundle, unless the group is
+// already defined otherwise and the policy is not to overwrite it, and
+// returns the outcome.
+func (this *PollarisCenter) importGroup(definition *l8tpollaris.L8PollarisGroupDefinition,
+	policy l8tpollaris.L8PConflictPolicy, isNotification bool) *l8tpollaris.L8PImportResult {
+	result := &l8tpollaris.L8PImportResult{Group: definition.Group,
+		Status: l8tpollaris.L8PImportStatus_L8PImport_Added}
+	existing := this.GroupDefinition(definition.Group)
+	if existing != nil && len(existing.Includes) > 0 {
+		if sameIncludes(existing, definition) {
+			result.Status = l8tpollaris.L8PImportStatus_L8PImport_Unchanged
+			return result
+		}
+		if policy != l8tpollaris.L8PConflictPolicy_L8PConflict_Overwrite {
+			result.Status = l8tpollaris.L8PImportStatus_L8PImport_Skipped
+			result.Message = "Group " + definition.Group + " is already defined"
+			return result
+		}
+		result.Status = l8tpollaris.L8PImportStatus_L8PImport_Overwritten
+		result.Message = "Replaced the existing group definition"
+	}
+	err := this.DefineGroup(proto.Clone(definition).(*l8tpollaris.L8PollarisGroupDefinition), isNotification)
+	if err != nil {
+		result.Status = l8tpollaris.L8PImportStatus_L8PImport_Failed
+		result.Message = err.Error()
+	}
+	return result
+}
+
+// freeName returns the first name derived from name that is not taken,
+// and marks it as taken.
+func freeName(name string, taken map[string]bool) string {
+	candidate := name + renameSuffix
+	for i := 2; taken[candidate]; i++ {
+		candidate = name + renameSuffix + "-" + strconv.Itoa(i)
+	}
+	taken[candidate] = true
+	return candidate
+}
+
+// inGroups reports whether the pollaris is in any of the groups, or true if
+// no groups are given.
+func inGroups(l8pollaris *l8tpollaris.L8Pollaris, groups []string) bool {
+	if len(groups) == 0 {
+		return true
+	}
+	for _, gName := range l8pollaris.Groups {
+		if contains(groups, gName) {
+			return true
+		}
+	}
+	return false
+}
+
+// ofVendors reports whether the pollaris vendor is any of the vendors,
+// ignoring case, or true if no vendors are given.
+func ofVendors(l8pollaris *l8tpollaris.L8Pollaris, vendors []string) bool {
+	if len(vendors) == 0 {
+		return true
+	}
+	for _, vendor := range vendors {
+		if strings.EqualFold(vendor, l8pollaris.Vendor) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalBundle encodes the bundle in the format named by the file extension:
+// ".json" for JSON, ".yaml" or ".yml" for YAML and anything else for protobuf.
+func MarshalBundle(bundle *l8tpollaris.L8PollarisBundle, ext string) ([]byte, error) {
+	switch strings.ToLower(ext) {
+	case ".json":
+		return protojson.MarshalOptions{Multiline: true}.Marshal(bundle)
+	case ".yaml", ".yml":
+		data, err := protojson.Marshal(bundle)
+		if err != nil {
+			return nil, err
+		}
+		return yaml.JSONToYAML(data)
+	}
+	return proto.Marshal(bundle)
+}
+
+// UnmarshalBundle decodes a bundle in the format named by the file extension,
+// see MarshalBundle.
+func UnmarshalBundle(data []byte, ext string) (*l8tpollaris.L8PollarisBundle, error) {
+	bundle := &l8tpollaris.L8PollarisBundle{}
+	var err error
+	switch strings.ToLower(ext) {
+	case ".json":
+		err = protojson.Unmarshal(data, bundle)
+	case ".yaml", ".yml":
+		data, err = yaml.YAMLToJSON(data)
+		if err == nil {
+			err = protojson.Unmarshal(data, bundle)
+		}
+	default:
+		err = proto.Unmarshal(data, bundle)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// WriteBundle writes the bundle to a file, in the format named by its extension.
+func WriteBundle(bundle *l8tpollaris.L8PollarisBundle, path string) error {
+	data, err := MarshalBundle(bundle, filepath.Ext(path))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// ReadBundle reads a bundle from a file, in the format named by its extension.
+func ReadBundle(path string) (*l8tpollaris.L8PollarisBundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalBundle(data, filepath.Ext(path))
+}
@@ -19,11 +19,12 @@ package pollaris
 
 import (
 	"errors"
+	"sort"
+	"strings"
 
 	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
 	"github.com/saichler/l8services/go/services/dcache"
 	"github.com/saichler/l8types/go/ifs"
-	"github.com/saichler/l8utils/go/utils/strings"
 
 	"sync"
 )
@@ -40,35 +41,92 @@ type PollarisCenter struct {
 	key2Name map[string]string
 	// groups maps group names to their member pollaris entries (key -> name)
 	groups map[string]map[string]string
+	// includes maps group names to their group definitions, see DefineGroup
+	includes map[string]*l8tpollaris.L8PollarisGroupDefinition
+	// group2Def is the distributed cache replicating the group definitions
+	group2Def ifs.IDistributedCache
 	// log provides logging capabilities for the center
 	log ifs.ILogger
-	// mtx protects concurrent access to key2Name and groups maps
+	// resources provides access to the registry for validation
+	resources ifs.IResources
+	// parserRules runs the l8parser rules of the dry runs by default
+	parserRules *ParserRules
+	// revisions keeps the last maxRevisions revisions of each pollaris, oldest first
+	revisions map[string][]*l8tpollaris.L8Pollaris
+	// maxRevisions is the number of revisions retained per pollaris
+	maxRevisions int
+	// area is the service area of the center, ServiceArea for the global one
+	area byte
+	// store persists the definitions, nil when persistence is not configured
+	store Store
+	// conflicts resolves concurrent changes received from peers
+	conflicts ConflictResolution
+	// vnic sends the change events to the subscribed services
+	vnic ifs.IVNic
+	// listeners are the in-process change listeners by name
+	listeners map[string]Listener
+	// subscriptions are the services subscribed to change events by service key
+	subscriptions map[string]*l8tpollaris.L8PollarisSubscription
+	// subsMtx protects the listeners and subscriptions maps
+	subsMtx *sync.RWMutex
+	// mtx protects concurrent access to key2Name, groups, includes and revisions maps
 	mtx *sync.RWMutex
 }
 
 // newPollarisCenter creates and initializes a new PollarisCenter instance.
 // It sets up the distributed cache, registers the L8Pollaris type with the
-// introspector, and populates initial data from the service level agreement.
-// The cache is created without synchronization (NoSync) for better performance.
+// introspector, and populates initial data from the service level agreement
+// and from the PollarisStore, when one is set, which also restores the
+// group definitions when it is a GroupStore.
+// The cache is created without synchronization (NoSync) for better performance,
+// unless the Synced mode is enabled.
 func newPollarisCenter(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) *PollarisCenter {
 	pc := &PollarisCenter{}
 	pc.key2Name = make(map[string]string)
 	pc.groups = make(map[string]map[string]string)
+	pc.includes = make(map[string]*l8tpollaris.L8PollarisGroupDefinition)
+	pc.revisions = make(map[string][]*l8tpollaris.L8Pollaris)
+	pc.maxRevisions = DefaultMaxRevisions
 	pc.log = vnic.Resources().Logger()
+	pc.resources = vnic.Resources()
+	pc.parserRules = NewParserRules(vnic.Resources())
 	pc.mtx = &sync.RWMutex{}
+	pc.area = sla.ServiceArea()
+	pc.store = storeOf(pc.area, pc.log)
+	pc.conflicts = Conflicts
+	pc.vnic = vnic
+	pc.listeners = make(map[string]Listener)
+	pc.subscriptions = make(map[string]*l8tpollaris.L8PollarisSubscription)
+	pc.subsMtx = &sync.RWMutex{}
 	vnic.Resources().Introspector().Decorators().AddPrimaryKeyDecorator(&l8tpollaris.L8Pollaris{}, "Name")
+	vnic.Resources().Introspector().Decorators().AddPrimaryKeyDecorator(&l8tpollaris.L8PollarisGroupDefinition{}, "Group")
 
-	if sla.InitItems() != nil {
-		vnic.Resources().Logger().Info("Initializing pollarisCenter with init elements ", len(sla.InitItems()))
-		for _, element := range sla.InitItems() {
+	initItems := loadStore(pc.store, sla.InitItems(), pc.log)
+	if initItems != nil {
+		vnic.Resources().Logger().Info("Initializing pollarisCenter with init elements ", len(initItems))
+		for _, element := range initItems {
 			pc.addForInit(element.(*l8tpollaris.L8Pollaris))
 		}
 	} else {
 		vnic.Resources().Logger().Info("Initializing pollarisCenter with no init elements")
 	}
 
-	pc.name2Poll = dcache.NewDistributedCacheNoSync(ServiceName, ServiceArea, &l8tpollaris.L8Pollaris{}, sla.InitItems(),
-		vnic, vnic.Resources())
+	if Synced {
+		pc.name2Poll = dcache.NewDistributedCache(ServiceName, pc.area, &l8tpollaris.L8Pollaris{}, initItems,
+			vnic, vnic.Resources())
+	} else {
+		pc.name2Poll = dcache.NewDistributedCacheNoSync(ServiceName, pc.area, &l8tpollaris.L8Pollaris{}, initItems,
+			vnic, vnic.Resources())
+	}
+
+	groupItems := pc.loadGroups()
+	if Synced {
+		pc.group2Def = dcache.NewDistributedCache(ServiceName, pc.area, &l8tpollaris.L8PollarisGroupDefinition{},
+			groupItems, vnic, vnic.Resources())
+	} else {
+		pc.group2Def = dcache.NewDistributedCacheNoSync(ServiceName, pc.area, &l8tpollaris.L8PollarisGroupDefinition{},
+			groupItems, vnic, vnic.Resources())
+	}
 
 	return pc
 }
@@ -83,42 +141,38 @@ func (this *PollarisCenter) getPollName(key string) (string, bool) {
 	return pollName, ok
 }
 
-// cleanupGroupsLocked removes a key from all groups of the existing pollaris.
+// removeIndexesLocked removes every key and group entry that points to the
+// pollaris with the given name, dropping groups that become empty.
 // Caller must hold this.mtx write lock.
-func (this *PollarisCenter) cleanupGroupsLocked(pollrs *l8tpollaris.L8Pollaris, key string) {
-	ePoll, _ := this.name2Poll.Get(pollrs)
-	if ePoll == nil {
-		return
-	}
-	existPoll, _ := ePoll.(*l8tpollaris.L8Pollaris)
-	if existPoll.Groups != nil {
-		for _, gName := range existPoll.Groups {
-			gEntry := this.groups[gName]
-			if gEntry != nil {
+func (this *PollarisCenter) removeIndexesLocked(name string) {
+	for key, pollName := range this.key2Name {
+		if pollName == name {
+			delete(this.key2Name, key)
+		}
+	}
+	for gName, gEntry := range this.groups {
+		for key, pollName := range gEntry {
+			if pollName == name {
 				delete(gEntry, key)
 			}
 		}
+		if len(gEntry) == 0 {
+			delete(this.groups, gName)
+		}
 	}
 }
 
-// AddAll adds multiple L8Pollaris configurations to the center.
-// Each pollaris is added using Post with isNotification=false.
-func (this *PollarisCenter) AddAll(pollarises []*l8tpollaris.L8Pollaris) {
-	for _, l8pollaris := range pollarises {
-		this.Post(l8pollaris, false)
-	}
-}
-
-// Post adds a new L8Pollaris configuration to the center.
-// It validates that the pollaris has a name and polling information,
-// removes any existing entry with the same key, and registers the new
-// pollaris in the distributed cache and group mappings.
-// Returns an error if validation fails.
-func (this *PollarisCenter) Post(l8pollaris *l8tpollaris.L8Pollaris, isNotification bool) error {
+// validate checks that the pollaris has a name and polling information,
+// that every poll has a What value and that its attribute patterns are well
+// formed. A pollaris that extends another one may omit its polling
+// information, but must not create an inheritance cycle, and the poll
+// dependencies must name existing polls without a cycle, see PollOrder.
+// Finally the registered validators are run, see Validate.
+func (this *PollarisCenter) validate(l8pollaris *l8tpollaris.L8Pollaris) error {
 	if l8pollaris.Name == "" {
 		return errors.New("Pollaris does not contain a Name")
 	}
-	if l8pollaris.Polling == nil {
+	if l8pollaris.Polling == nil && l8pollaris.Extends == "" {
 		return errors.New("Pollaris does not contain any polling information")
 	}
 
@@ -128,12 +182,82 @@ func (this *PollarisCenter) Post(l8pollaris *l8tpollaris.L8Pollaris, isNotificat
 		}
 	}
 
+	err := checkPatterns(l8pollaris)
+	if err != nil {
+		return err
+	}
+
+	err = this.checkExtends(l8pollaris)
+	if err != nil {
+		return err
+	}
+
+	err = this.checkDependencies(l8pollaris)
+	if err != nil {
+		return err
+	}
+
+	errs := Validate(l8pollaris, this.resources)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// AddAll adds multiple L8Pollaris configurations to the center.
+// Each pollaris is added using Post with isNotification=false. A pollaris
+// that fails to be added is logged and does not stop the others from being
+// added. Returns an error listing every pollaris that failed, or nil.
+func (this *PollarisCenter) AddAll(pollarises []*l8tpollaris.L8Pollaris) error {
+	failed := make([]string, 0)
+	for _, l8pollaris := range pollarises {
+		err := this.Post(l8pollaris, false)
+		if err != nil {
+			this.log.Error("Cannot add Pollaris ", l8pollaris.Name, ": ", err.Error())
+			failed = append(failed, l8pollaris.Name+": "+err.Error())
+		}
+	}
+	if len(failed) > 0 {
+		return errors.New("Cannot add Pollaris " + strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// Post adds a new L8Pollaris configuration to the center.
+// It validates that the pollaris has a name and polling information, and
+// that it keeps the poll dependencies of the pollarises extending it,
+// ignores a notification older than the stored definition,
+// removes any existing entry with the same name, assigns the next revision,
+// registers the new pollaris in the distributed cache and group mappings,
+// publishes the change to the subscribers and saves it to the PollarisStore,
+// if one is set.
+// Returns an error if validation or persistence fails.
+func (this *PollarisCenter) Post(l8pollaris *l8tpollaris.L8Pollaris, isNotification bool) error {
+	err := this.validate(l8pollaris)
+	if err != nil {
+		return err
+	}
+
 	key := this.PollarisKey(l8pollaris)
 
 	// Hold write lock across cleanup and re-add to prevent races where
 	// concurrent readers see the key temporarily missing from key2Name.
 	this.mtx.Lock()
-	this.cleanupGroupsLocked(l8pollaris, key)
+	if this.staleLocked(l8pollaris, isNotification) {
+		this.mtx.Unlock()
+		return nil
+	}
+	if !isNotification {
+		err = this.checkDependentsLocked(l8pollaris)
+		if err != nil {
+			this.mtx.Unlock()
+			return err
+		}
+	}
+	previous := this.previousLocked(l8pollaris.Name)
+	this.removeIndexesLocked(l8pollaris.Name)
+	this.stampLocked(l8pollaris, isNotification)
+	this.addRevisionLocked(l8pollaris, isNotification)
 	this.key2Name[key] = l8pollaris.Name
 	if l8pollaris.Groups != nil {
 		for _, gName := range l8pollaris.Groups {
@@ -148,8 +272,9 @@ func (this *PollarisCenter) Post(l8pollaris *l8tpollaris.L8Pollaris, isNotificat
 	this.mtx.Unlock()
 
 	this.name2Poll.Post(l8pollaris, isNotification)
+	this.publish(previous, l8pollaris, isNotification)
 
-	return nil
+	return this.persist(l8pollaris)
 }
 
 // addForInit adds a pollaris during initialization without triggering
@@ -157,6 +282,7 @@ func (this *PollarisCenter) Post(l8pollaris *l8tpollaris.L8Pollaris, isNotificat
 // (key2Name and groups) from the initial data set.
 func (this *PollarisCenter) addForInit(p *l8tpollaris.L8Pollaris) {
 	key := this.PollarisKey(p)
+	this.addRevisionLocked(p, true)
 	this.key2Name[key] = p.Name
 	if p.Groups != nil {
 		for _, gName := range p.Groups {
@@ -171,21 +297,22 @@ func (this *PollarisCenter) addForInit(p *l8tpollaris.L8Pollaris) {
 }
 
 // Put updates an existing L8Pollaris configuration in the center.
-// It performs the same validation as Post, removes any existing entry,
-// and stores the updated pollaris in the distributed cache.
-// Returns an error if validation fails.
+// It performs the same validation and ordering as Post, removes any existing entry,
+// assigns the next revision, stores the updated pollaris in the distributed cache,
+// publishes the change to the subscribers and saves it to the PollarisStore,
+// if one is set.
+// Returns an error if validation or persistence fails.
 func (this *PollarisCenter) Put(l8pollaris *l8tpollaris.L8Pollaris, isNotification bool) error {
-	if l8pollaris.Name == "" {
-		return errors.New("Pollaris does not contain a Name")
-	}
-	if l8pollaris.Polling == nil {
-		return errors.New("Pollaris does not contain any polling information")
-	}
+	return this.put(l8pollaris, isNotification, 0)
+}
 
-	for _, poll := range l8pollaris.Polling {
-		if poll.What == "" {
-			return errors.New("Pollaris " + l8pollaris.Name + ": poll does not contain a What value")
-		}
+// put implements Put. A non-zero revision makes the update conditional: it is
+// only applied while the stored definition is still at that revision, and
+// errConcurrentChange is returned otherwise.
+func (this *PollarisCenter) put(l8pollaris *l8tpollaris.L8Pollaris, isNotification bool, revision int64) error {
+	err := this.validate(l8pollaris)
+	if err != nil {
+		return err
 	}
 
 	key := this.PollarisKey(l8pollaris)
@@ -193,7 +320,28 @@ func (this *PollarisCenter) Put(l8pollaris *l8tpollaris.L8Pollaris, isNotificati
 	// Hold write lock across cleanup and re-add to prevent races where
 	// concurrent readers see the key temporarily missing from key2Name.
 	this.mtx.Lock()
-	this.cleanupGroupsLocked(l8pollaris, key)
+	if revision != 0 {
+		existing := this.Definition(l8pollaris.Name)
+		if existing == nil || existing.Revision != revision {
+			this.mtx.Unlock()
+			return errConcurrentChange
+		}
+	}
+	if this.staleLocked(l8pollaris, isNotification) {
+		this.mtx.Unlock()
+		return nil
+	}
+	if !isNotification {
+		err = this.checkDependentsLocked(l8pollaris)
+		if err != nil {
+			this.mtx.Unlock()
+			return err
+		}
+	}
+	previous := this.previousLocked(l8pollaris.Name)
+	this.removeIndexesLocked(l8pollaris.Name)
+	this.stampLocked(l8pollaris, isNotification)
+	this.addRevisionLocked(l8pollaris, isNotification)
 	this.key2Name[key] = l8pollaris.Name
 	if l8pollaris.Groups != nil {
 		for _, gName := range l8pollaris.Groups {
@@ -208,8 +356,91 @@ func (this *PollarisCenter) Put(l8pollaris *l8tpollaris.L8Pollaris, isNotificati
 	this.mtx.Unlock()
 
 	this.name2Poll.Put(l8pollaris, isNotification)
+	this.publish(previous, l8pollaris, isNotification)
 
-	return nil
+	return this.persist(l8pollaris)
+}
+
+// Delete removes the L8Pollaris with the same name as the given one from
+// the center. The key and group indexes are cleaned up and the entry is
+// removed from the distributed cache while holding the write lock, so
+// readers never observe a partially removed pollaris. Unless this is a
+// notification, the removal is propagated to the peers by the cache. A
+// notification removing an older revision than the stored one is ignored.
+// The removal is published to the subscribers, see Subscribe.
+// A tombstone of the pollaris is then saved to the PollarisStore, if one is
+// set, so it is not restored on restart, see Store.
+// Returns an error if the pollaris does not exist, or if other pollarises
+// extend it, as deleting it would break their inheritance; they must be
+// deleted, or extend another pollaris, first. Notifications are applied
+// regardless, as the peer has already checked them.
+func (this *PollarisCenter) Delete(l8pollaris *l8tpollaris.L8Pollaris, isNotification bool) error {
+	if l8pollaris == nil || l8pollaris.Name == "" {
+		return errors.New("Pollaris does not contain a Name")
+	}
+	this.mtx.Lock()
+	existing := this.Definition(l8pollaris.Name)
+	if existing == nil {
+		this.mtx.Unlock()
+		return errors.New("Cannot find Pollaris " + l8pollaris.Name)
+	}
+	if isNotification && l8pollaris.Revision != 0 && this.newer(existing, l8pollaris) {
+		this.mtx.Unlock()
+		this.log.Info("Ignoring stale delete of Pollaris ", existing.Name, " revision ", l8pollaris.Revision)
+		return nil
+	}
+	if !isNotification {
+		children := this.extendedByLocked(existing.Name)
+		if len(children) > 0 {
+			this.mtx.Unlock()
+			return errors.New("Pollaris " + existing.Name + " cannot be deleted, it is extended by " +
+				strings.Join(children, ", "))
+		}
+	}
+	previous := this.previousLocked(existing.Name)
+	this.removeIndexesLocked(existing.Name)
+	this.name2Poll.Delete(existing, isNotification)
+	this.mtx.Unlock()
+	this.publish(previous, nil, isNotification)
+	return this.unpersist(existing.Name)
+}
+
+// DeleteByKey removes the L8Pollaris registered under the exact composite
+// key built from args (name, vendor, series, family, software, hardware,
+// version). Unlike PollarisByKey, there is no fallback to less specific
+// keys, so a generic pollaris is never removed by mistake.
+func (this *PollarisCenter) DeleteByKey(isNotification bool, args ...string) error {
+	key := keyOf(args...)
+	name, ok := this.getPollName(key)
+	if !ok {
+		return errors.New("Cannot find Pollaris for key " + key)
+	}
+	return this.Delete(&l8tpollaris.L8Pollaris{Name: name}, isNotification)
+}
+
+// DeleteByQuery removes every L8Pollaris matching the given query and
+// returns the names of the removed pollarises. Derived pollarises are
+// removed before the pollarises they extend, so a query matching a whole
+// inheritance chain can remove it. It stops at, and returns, the first
+// error encountered.
+func (this *PollarisCenter) DeleteByQuery(query ifs.IQuery, isNotification bool) ([]string, error) {
+	deleted := make([]string, 0)
+	matched := this.Query(query)
+	depths := make(map[string]int, len(matched))
+	for _, l8pollaris := range matched {
+		depths[l8pollaris.Name] = this.depthOf(l8pollaris.Name)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return depths[matched[i].Name] > depths[matched[j].Name]
+	})
+	for _, l8pollaris := range matched {
+		err := this.Delete(l8pollaris, isNotification)
+		if err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, l8pollaris.Name)
+	}
+	return deleted, nil
 }
 
 // PollarisKey generates a composite key for the given L8Pollaris.
@@ -219,10 +450,27 @@ func (this *PollarisCenter) PollarisKey(l8pollaris *l8tpollaris.L8Pollaris) stri
 	return pollarisKey(l8pollaris.Name, l8pollaris.Vendor, l8pollaris.Series, l8pollaris.Family, l8pollaris.Software, l8pollaris.Hardware, l8pollaris.Version)
 }
 
-// PollarisByName retrieves a L8Pollaris configuration by its name.
+// PollarisByName retrieves the effective L8Pollaris configuration by its name,
+// with the polls inherited through Extends resolved.
+// Returns nil if the center is nil, the cache is nil, no pollaris with the
+// given name exists, or its inheritance chain cannot be resolved.
+func (this *PollarisCenter) PollarisByName(name string) *l8tpollaris.L8Pollaris {
+	if this == nil || this.name2Poll == nil {
+		return nil
+	}
+	poll, err := this.Effective(name)
+	if err != nil {
+		this.log.Error(err.Error())
+		return nil
+	}
+	return poll
+}
+
+// Definition retrieves the L8Pollaris configuration stored under the given
+// name as it was posted, without resolving inheritance.
 // Returns nil if the center is nil, the cache is nil, or no pollaris
 // with the given name exists.
-func (this *PollarisCenter) PollarisByName(name string) *l8tpollaris.L8Pollaris {
+func (this *PollarisCenter) Definition(name string) *l8tpollaris.L8Pollaris {
 	if this == nil || this.name2Poll == nil {
 		return nil
 	}
@@ -232,35 +480,110 @@ func (this *PollarisCenter) PollarisByName(name string) *l8tpollaris.L8Pollaris
 	return poll
 }
 
+// AllNames returns the names of all pollarises known to the center, sorted
+// alphabetically. This method is thread-safe using a read lock.
+func (this *PollarisCenter) AllNames() []string {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	set := make(map[string]bool)
+	for _, name := range this.key2Name {
+		set[name] = true
+	}
+	result := make([]string, 0, len(set))
+	for name := range set {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Query returns all L8Pollaris definitions matching the given query,
+// sorted by name. A nil query matches every definition.
+func (this *PollarisCenter) Query(query ifs.IQuery) []*l8tpollaris.L8Pollaris {
+	result := make([]*l8tpollaris.L8Pollaris, 0)
+	if this == nil || this.name2Poll == nil {
+		return result
+	}
+	for _, name := range this.AllNames() {
+		l8pollaris := this.Definition(name)
+		if l8pollaris == nil {
+			continue
+		}
+		if query != nil && !query.Match(l8pollaris) {
+			continue
+		}
+		result = append(result, l8pollaris)
+	}
+	return result
+}
+
 // PollarisByKey retrieves a L8Pollaris using a hierarchical key lookup.
 // The args should be provided in order: name, vendor, series, family,
-// software, hardware, version. If an exact match is not found, the method
-// recursively tries with fewer components (falling back to less specific matches).
+// software, hardware, version. If the exact composite key is not registered,
+// the named pollaris and every pollaris extending it are matched against the
+// device attributes, honoring glob, regex and version range patterns (see
+// matchField), and the best match is returned. Candidates that contradict the
+// device, or that constrain an attribute the device does not report, are
+// skipped; among the rest, more matched and more specific attributes win,
+// then the narrower matches, see match.better.
+// The returned pollaris is the effective one, with inheritance resolved.
+// A tenant center that has no match falls back to the global ServiceArea.
 // Returns nil if no matching pollaris is found.
 func (this *PollarisCenter) PollarisByKey(args ...string) *l8tpollaris.L8Pollaris {
-	if args == nil || len(args) == 0 {
+	name := this.resolveKey(args, nil)
+	if name == "" {
+		if global := this.global(); global != nil {
+			return global.PollarisByKey(args...)
+		}
 		return nil
 	}
-	if len(args) == 1 {
-		pollName := this.key2Name[args[0]]
-		filter := &l8tpollaris.L8Pollaris{Name: pollName}
-		p, _ := this.name2Poll.Get(filter)
-		poll, _ := p.(*l8tpollaris.L8Pollaris)
-		return poll
+	return this.PollarisByName(name)
+}
+
+// resolveKey returns the name of the pollaris PollarisByKey resolves the args
+// to, or an empty string if none matches. When trace is not nil, the keys
+// tried and the candidates considered are recorded in it.
+func (this *PollarisCenter) resolveKey(args []string, trace *l8tpollaris.L8PollarisExplain) string {
+	if len(args) == 0 {
+		return ""
 	}
-	buff := strings.New()
-	buff.Add(args[0])
-	for i := 1; i < len(args); i++ {
-		addToKey(args[i], buff)
+	key := keyOf(args...)
+	if trace != nil {
+		trace.TriedKeys = append(trace.TriedKeys, key)
 	}
-	p, ok := this.getPollName(buff.String())
+	p, ok := this.getPollName(key)
 	if ok {
-		filter := &l8tpollaris.L8Pollaris{Name: p}
-		f, _ := this.name2Poll.Get(filter)
-		poll, _ := f.(*l8tpollaris.L8Pollaris)
-		return poll
+		if trace != nil {
+			trace.MatchedKey = key
+		}
+		return p
 	}
-	return this.PollarisByKey(args[0 : len(args)-1]...)
+	return this.bestVariant(args[0], deviceOf(args[1:]), trace)
+}
+
+// Explain resolves a key lookup like PollarisByKey and returns a trace of
+// the resolution: the keys tried, the key that matched, every candidate
+// considered with the reason it was chosen or skipped, and the chosen
+// effective pollaris. The request carries the name and device attributes.
+// A tenant center that has no match falls back to the global ServiceArea,
+// and the trace keeps the tenant keys and candidates ahead of the global ones.
+func (this *PollarisCenter) Explain(request *l8tpollaris.L8PollarisExplain) *l8tpollaris.L8PollarisExplain {
+	trace := &l8tpollaris.L8PollarisExplain{Name: request.Name, Vendor: request.Vendor, Series: request.Series,
+		Family: request.Family, Software: request.Software, Hardware: request.Hardware, Version: request.Version}
+	args := []string{request.Name, request.Vendor, request.Series, request.Family,
+		request.Software, request.Hardware, request.Version}
+	center := this
+	name := this.resolveKey(args, trace)
+	if name == "" {
+		if global := this.global(); global != nil {
+			center = global
+			name = global.resolveKey(args, trace)
+		}
+	}
+	if name != "" {
+		trace.Result = center.PollarisByName(name)
+	}
+	return trace
 }
 
 // Poll retrieves a specific L8Poll (polling job) from a named pollaris.
@@ -278,32 +601,32 @@ func (this *PollarisCenter) Poll(pollarisName, jobName string) *l8tpollaris.L8Po
 	return poll
 }
 
-// Names returns all pollaris names belonging to the specified group.
-// The vendor, series, family, software, hardware, and version parameters
-// are reserved for future filtering but currently unused.
+// Names returns the names of the pollarises in the specified group, and in
+// the groups it includes recursively (see DefineGroup), that apply to a
+// device with the given attributes. Members whose attributes contradict the
+// device are excluded, and among the members that are variants of the same
+// logical pollaris (sharing the same Extends root), only the most specific
+// match is returned. Empty device attributes are treated as unknown.
+// A tenant center also considers the members of the global group that it
+// does not shadow with a pollaris of the same name.
 // Returns an empty slice if the group doesn't exist.
 func (this *PollarisCenter) Names(groupName, vendor, series, family, software, hardware, version string) []string {
-	this.mtx.RLock()
-	defer this.mtx.RUnlock()
-	result := make([]string, 0)
-	group, ok := this.groups[groupName]
+	members, ok := this.expandGroup(groupName)
 	if !ok {
-		return result
+		return members
 	}
-	for _, name := range group {
-		result = append(result, name)
-	}
-	return result
+	dev := attributes{vendor, series, family, software, hardware, version}
+	return this.selectMembers(members, dev)
 }
 
-// PollsByGroup retrieves all L8Pollaris configurations belonging to a group.
-// It uses hierarchical key lookup for each member, allowing vendor/series/family
-// specific overrides. Returns an empty slice if no matching pollarises are found.
+// PollsByGroup retrieves the effective L8Pollaris configurations of a group
+// that apply to a device with the given attributes, as selected by Names.
+// Returns an empty slice if no matching pollarises are found.
 func (this *PollarisCenter) PollsByGroup(groupName, vendor, series, family, software, hardware, version string) []*l8tpollaris.L8Pollaris {
 	names := this.Names(groupName, vendor, series, family, software, hardware, version)
 	result := make([]*l8tpollaris.L8Pollaris, 0)
 	for _, name := range names {
-		poll := this.PollarisByKey(name, vendor, series, family, software, hardware, version)
+		poll := this.PollarisByName(name)
 		if poll != nil {
 			result = append(result, poll)
 		}
@@ -315,11 +638,22 @@ func (this *PollarisCenter) PollsByGroup(groupName, vendor, series, family, soft
 // This is the main entry point for accessing the polling configuration
 // management functionality. Returns nil if the service is not found.
 func Pollaris(resource ifs.IResources) *PollarisCenter {
-	sp, ok := resource.Services().ServiceHandler(ServiceName, ServiceArea)
+	return PollarisOfArea(resource, ServiceArea)
+}
+
+// PollarisOfArea retrieves the PollarisCenter of a service area, such as a
+// tenant area activated with ActivateArea. Returns nil if the service is not
+// found.
+func PollarisOfArea(resource ifs.IResources, area byte) *PollarisCenter {
+	sp, ok := resource.Services().ServiceHandler(ServiceName, area)
+	if !ok {
+		return nil
+	}
+	service, ok := sp.(*PollarisService)
 	if !ok {
 		return nil
 	}
-	return (sp.(*PollarisService)).pollarisCenter
+	return service.pollarisCenter
 }
 
 // Poll is a convenience function to retrieve a specific polling job.
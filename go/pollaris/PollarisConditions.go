@@ -0,0 +1,49 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+
+	"github.com/saichler/l8pollaris/go/pollaris/condition"
+)
+
+// ApplicablePolls returns the names of the polls of the effective named
+// pollaris that apply in the context, in execution order, see ExecutionOrder.
+// A poll applies when it has no condition or its condition holds, so
+// collectors can skip the polls that are irrelevant to a target.
+func (this *PollarisCenter) ApplicablePolls(name string, ctx condition.Context) ([]string, error) {
+	effective, err := this.Effective(name)
+	if err != nil {
+		return nil, err
+	}
+	if effective == nil {
+		return nil, errors.New("Cannot find Pollaris " + name)
+	}
+	order, err := PollOrder(effective)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(order))
+	for _, pollName := range order {
+		applies, err := condition.Applies(effective.Polling[pollName], ctx)
+		if err != nil {
+			return nil, errors.New("Poll " + pollName + " of Pollaris " + name + ": " + err.Error())
+		}
+		if applies {
+			result = append(result, pollName)
+		}
+	}
+	return result, nil
+}
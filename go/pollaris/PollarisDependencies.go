@@ -0,0 +1,183 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+)
+
+// PollOrder returns the names of the polls of the pollaris in an execution
+// order where every poll comes after the polls it depends on. Polls that do
+// not depend on each other are ordered by name, so the order is stable.
+// Returns an error if a poll depends on a poll that is not in the pollaris,
+// or the dependencies contain a cycle.
+func PollOrder(l8pollaris *l8tpollaris.L8Pollaris) ([]string, error) {
+	return pollOrder(l8pollaris.Name, l8pollaris.Polling, true)
+}
+
+// ExecutionOrder returns the execution order of the polls of the effective
+// named pollaris, with its inherited polls, see PollOrder. Dependencies are
+// checked when a pollaris, or a base it extends, is stored, but a base that
+// was missing then may break them, in which case the error is reported here.
+func (this *PollarisCenter) ExecutionOrder(name string) ([]string, error) {
+	effective, err := this.Effective(name)
+	if err != nil {
+		return nil, err
+	}
+	if effective == nil {
+		return nil, errors.New("Cannot find Pollaris " + name)
+	}
+	return PollOrder(effective)
+}
+
+// checkDependencies verifies that the poll dependencies of the pollaris, with
+// the polls it inherits, name existing polls and contain no cycle. As with a
+// missing base pollaris, dependencies on polls that may be inherited from a
+// missing base are accepted; they are reported when the order is resolved.
+func (this *PollarisCenter) checkDependencies(l8pollaris *l8tpollaris.L8Pollaris) error {
+	polls, complete := this.pollsWithBase(l8pollaris)
+	_, err := pollOrder(l8pollaris.Name, polls, complete)
+	return err
+}
+
+// checkDependentsLocked verifies that storing the pollaris keeps the poll
+// dependencies of the pollarises extending it, directly or not, satisfied,
+// as a base must not drop a poll they depend on or create a cycle with
+// their polls. Dependents of a base that cannot be resolved are not checked,
+// see checkDependencies.
+// Caller must hold this.mtx lock.
+func (this *PollarisCenter) checkDependentsLocked(l8pollaris *l8tpollaris.L8Pollaris) error {
+	base, err := this.effectiveOf(l8pollaris)
+	if err != nil {
+		return nil
+	}
+	type level struct {
+		name  string
+		polls map[string]*l8tpollaris.L8Poll
+	}
+	pending := []level{{name: l8pollaris.Name, polls: base.Polling}}
+	visited := map[string]bool{l8pollaris.Name: true}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		for _, name := range this.extendedByLocked(current.name) {
+			definition := this.Definition(name)
+			if definition == nil || visited[name] {
+				continue
+			}
+			visited[name] = true
+			polls := make(map[string]*l8tpollaris.L8Poll, len(current.polls)+len(definition.Polling))
+			for pollName, poll := range current.polls {
+				polls[pollName] = poll
+			}
+			for pollName, poll := range definition.Polling {
+				polls[pollName] = poll
+			}
+			for _, pollName := range definition.Excludes {
+				delete(polls, pollName)
+			}
+			_, err = pollOrder(name, polls, true)
+			if err != nil {
+				return errors.New("Pollaris " + l8pollaris.Name + " breaks the poll dependencies of " + name + ": " +
+					err.Error())
+			}
+			pending = append(pending, level{name: name, polls: polls})
+		}
+	}
+	return nil
+}
+
+// pollsWithBase returns the polls of the pollaris merged over the effective
+// polls of its base, and whether the base could be resolved.
+func (this *PollarisCenter) pollsWithBase(l8pollaris *l8tpollaris.L8Pollaris) (map[string]*l8tpollaris.L8Poll, bool) {
+	if l8pollaris.Extends == "" {
+		return l8pollaris.Polling, true
+	}
+	base, err := this.Effective(l8pollaris.Extends)
+	if err != nil || base == nil {
+		return l8pollaris.Polling, false
+	}
+	polls := make(map[string]*l8tpollaris.L8Poll, len(base.Polling)+len(l8pollaris.Polling))
+	for pollName, poll := range base.Polling {
+		polls[pollName] = poll
+	}
+	for pollName, poll := range l8pollaris.Polling {
+		polls[pollName] = poll
+	}
+	for _, pollName := range l8pollaris.Excludes {
+		delete(polls, pollName)
+	}
+	return polls, true
+}
+
+// pollOrder orders the polls by their dependencies with a depth first walk in
+// poll name order. When strict is false, dependencies on polls that are not
+// in the map are ignored instead of reported.
+func pollOrder(name string, polls map[string]*l8tpollaris.L8Poll, strict bool) ([]string, error) {
+	names := make([]string, 0, len(polls))
+	for pollName := range polls {
+		names = append(names, pollName)
+	}
+	sort.Strings(names)
+
+	order := make([]string, 0, len(names))
+	// done holds the ordered polls, and visiting and path the polls being walked
+	done := make(map[string]bool, len(names))
+	visiting := make(map[string]bool)
+	path := make([]string, 0)
+	var visit func(pollName string) error
+	visit = func(pollName string) error {
+		if done[pollName] {
+			return nil
+		}
+		if visiting[pollName] {
+			start := 0
+			for path[start] != pollName {
+				start++
+			}
+			cycle := append(append([]string{}, path[start:]...), pollName)
+			return errors.New("Pollaris " + name + ": poll dependency cycle " + strings.Join(cycle, " -> "))
+		}
+		visiting[pollName] = true
+		path = append(path, pollName)
+		for _, dependency := range sortedCopy(polls[pollName].DependsOn) {
+			if _, ok := polls[dependency]; !ok {
+				if strict {
+					return errors.New("Pollaris " + name + ": poll " + pollName + " depends on unknown poll " + dependency)
+				}
+				continue
+			}
+			err := visit(dependency)
+			if err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		delete(visiting, pollName)
+		done[pollName] = true
+		order = append(order, pollName)
+		return nil
+	}
+	for _, pollName := range names {
+		err := visit(pollName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
@@ -0,0 +1,317 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+)
+
+// differ accumulates the changes found while comparing two definitions.
+type differ struct {
+	// changes are the changes found, in comparison order
+	changes []*l8tpollaris.L8PChange
+}
+
+// add records a change of type at path.
+func (this *differ) add(typ l8tpollaris.L8PChangeType, path, oldValue, newValue string) {
+	this.changes = append(this.changes, &l8tpollaris.L8PChange{Type: typ, Path: path,
+		OldValue: oldValue, NewValue: newValue})
+}
+
+// value records a change of a single value, as added when it was empty,
+// removed when it becomes empty and changed otherwise.
+func (this *differ) value(path, oldValue, newValue string) {
+	switch {
+	case oldValue == newValue:
+	case oldValue == "":
+		this.add(l8tpollaris.L8PChangeType_L8PChange_Added, path, oldValue, newValue)
+	case newValue == "":
+		this.add(l8tpollaris.L8PChangeType_L8PChange_Removed, path, oldValue, newValue)
+	default:
+		this.add(l8tpollaris.L8PChangeType_L8PChange_Changed, path, oldValue, newValue)
+	}
+}
+
+// members records the values added to and removed from a set, such as groups.
+func (this *differ) members(path string, oldValues, newValues []string) {
+	for _, value := range sortedCopy(newValues) {
+		if !contains(oldValues, value) {
+			this.add(l8tpollaris.L8PChangeType_L8PChange_Added, path, "", value)
+		}
+	}
+	for _, value := range sortedCopy(oldValues) {
+		if !contains(newValues, value) {
+			this.add(l8tpollaris.L8PChangeType_L8PChange_Removed, path, value, "")
+		}
+	}
+}
+
+// Diff compares two pollaris definitions and returns the changes from one to
+// the other: device attributes, inheritance, group membership, added and
+// removed polls, and for the polls in both the changes of their fields,
+// cadence plan, attributes and rules. A nil definition compares as empty.
+func Diff(from, to *l8tpollaris.L8Pollaris) []*l8tpollaris.L8PChange {
+	if from == nil {
+		from = &l8tpollaris.L8Pollaris{}
+	}
+	if to == nil {
+		to = &l8tpollaris.L8Pollaris{}
+	}
+	d := &differ{changes: make([]*l8tpollaris.L8PChange, 0)}
+	d.value("name", from.Name, to.Name)
+	d.value("vendor", from.Vendor, to.Vendor)
+	d.value("series", from.Series, to.Series)
+	d.value("family", from.Family, to.Family)
+	d.value("software", from.Software, to.Software)
+	d.value("hardware", from.Hardware, to.Hardware)
+	d.value("version", from.Version, to.Version)
+	d.value("extends", from.Extends, to.Extends)
+	d.members("excludes", from.Excludes, to.Excludes)
+	d.members("groups", from.Groups, to.Groups)
+
+	for _, pollName := range pollNamesOf(from, to) {
+		path := pollPath(pollName)
+		fromPoll, inFrom := from.Polling[pollName]
+		toPoll, inTo := to.Polling[pollName]
+		switch {
+		case !inFrom:
+			d.add(l8tpollaris.L8PChangeType_L8PChange_Added, path, "", pollString(toPoll))
+		case !inTo:
+			d.add(l8tpollaris.L8PChangeType_L8PChange_Removed, path, pollString(fromPoll), "")
+		default:
+			d.poll(path, fromPoll, toPoll)
+		}
+	}
+	return d.changes
+}
+
+// poll records the changes between two versions of a poll.
+func (this *differ) poll(path string, from, to *l8tpollaris.L8Poll) {
+	this.value(path+".what", from.What, to.What)
+	this.value(path+".operation", enumString(int32(from.Operation), from.Operation.String()),
+		enumString(int32(to.Operation), to.Operation.String()))
+	this.value(path+".protocol", enumString(int32(from.Protocol), from.Protocol.String()),
+		enumString(int32(to.Protocol), to.Protocol.String()))
+	this.value(path+".timeout", int64String(from.Timeout), int64String(to.Timeout))
+	this.value(path+".bodyName", from.BodyName, to.BodyName)
+	this.value(path+".respName", from.RespName, to.RespName)
+	this.value(path+".always", strconv.FormatBool(from.Always), strconv.FormatBool(to.Always))
+	this.members(path+".dependsOn", from.DependsOn, to.DependsOn)
+	this.value(path+".condition", from.Condition, to.Condition)
+	this.cadence(path+".cadence", from.Cadence, to.Cadence)
+
+	fromAttrs := attributesByProperty(from)
+	toAttrs := attributesByProperty(to)
+	ids := make([]string, 0, len(fromAttrs)+len(toAttrs))
+	for id := range fromAttrs {
+		ids = append(ids, id)
+	}
+	for id := range toAttrs {
+		if _, ok := fromAttrs[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		attrPath := path + ".attributes[" + id + "]"
+		fromAttr, inFrom := fromAttrs[id]
+		toAttr, inTo := toAttrs[id]
+		switch {
+		case !inFrom:
+			this.add(l8tpollaris.L8PChangeType_L8PChange_Added, attrPath, "", rulesString(toAttr.Rules))
+		case !inTo:
+			this.add(l8tpollaris.L8PChangeType_L8PChange_Removed, attrPath, rulesString(fromAttr.Rules), "")
+		default:
+			this.rules(attrPath, fromAttr.Rules, toAttr.Rules)
+		}
+	}
+}
+
+// cadence records the changes between two cadence plans.
+func (this *differ) cadence(path string, from, to *l8tpollaris.L8PCadencePlan) {
+	switch {
+	case from == nil && to == nil:
+	case from == nil:
+		this.add(l8tpollaris.L8PChangeType_L8PChange_Added, path, "", cadenceString(to))
+	case to == nil:
+		this.add(l8tpollaris.L8PChangeType_L8PChange_Removed, path, cadenceString(from), "")
+	default:
+		this.value(path+".cadences", int64sString(from.Cadences), int64sString(to.Cadences))
+		this.value(path+".startups", int64sString(from.Startups), int64sString(to.Startups))
+		this.value(path+".current", int64String(int64(from.Current)), int64String(int64(to.Current)))
+		this.value(path+".enabled", strconv.FormatBool(from.Enabled), strconv.FormatBool(to.Enabled))
+	}
+}
+
+// rules records the changes between two rule lists, compared by position.
+func (this *differ) rules(path string, from, to []*l8tpollaris.L8PRule) {
+	for i := 0; i < len(from) || i < len(to); i++ {
+		rulePath := path + ".rules[" + strconv.Itoa(i) + "]"
+		switch {
+		case i >= len(from):
+			this.add(l8tpollaris.L8PChangeType_L8PChange_Added, rulePath, "", ruleString(to[i]))
+		case i >= len(to):
+			this.add(l8tpollaris.L8PChangeType_L8PChange_Removed, rulePath, ruleString(from[i]), "")
+		default:
+			this.value(rulePath, ruleString(from[i]), ruleString(to[i]))
+		}
+	}
+}
+
+// RenderDiff renders the changes as text, one change per line, prefixed with
+// "+" for added, "-" for removed and "~" for changed values.
+func RenderDiff(changes []*l8tpollaris.L8PChange) string {
+	buff := strings.Builder{}
+	for _, change := range changes {
+		switch change.Type {
+		case l8tpollaris.L8PChangeType_L8PChange_Added:
+			buff.WriteString("+ " + change.Path + ": " + change.NewValue)
+		case l8tpollaris.L8PChangeType_L8PChange_Removed:
+			buff.WriteString("- " + change.Path + ": " + change.OldValue)
+		default:
+			buff.WriteString("~ " + change.Path + ": " + change.OldValue + " -> " + change.NewValue)
+		}
+		buff.WriteString("\n")
+	}
+	return buff.String()
+}
+
+// DiffOf resolves the definitions of a diff request and returns the response
+// with the changes and their rendering. When the request carries a name, its
+// from_revision is compared to its to_revision, or to the current definition
+// when to_revision is zero; otherwise the from and to definitions are compared.
+// Returns an error if a requested revision is not retained.
+func (this *PollarisCenter) DiffOf(request *l8tpollaris.L8PollarisDiff) (*l8tpollaris.L8PollarisDiff, error) {
+	from, to := request.From, request.To
+	if request.Name != "" {
+		from = this.Revision(request.Name, request.FromRevision)
+		if from == nil {
+			return nil, errors.New("Cannot find revision " + int64String(request.FromRevision) +
+				" of Pollaris " + request.Name)
+		}
+		if request.ToRevision == 0 {
+			to = this.Definition(request.Name)
+		} else {
+			to = this.Revision(request.Name, request.ToRevision)
+		}
+		if to == nil {
+			return nil, errors.New("Cannot find revision " + int64String(request.ToRevision) +
+				" of Pollaris " + request.Name)
+		}
+	}
+	changes := Diff(from, to)
+	return &l8tpollaris.L8PollarisDiff{Name: request.Name, FromRevision: request.FromRevision,
+		ToRevision: request.ToRevision, From: from, To: to, Changes: changes, Text: RenderDiff(changes)}, nil
+}
+
+// pollNamesOf returns the names of the polls in either definition, sorted.
+func pollNamesOf(from, to *l8tpollaris.L8Pollaris) []string {
+	names := sortedPollNames(from)
+	for _, name := range sortedPollNames(to) {
+		if _, ok := from.Polling[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// attributesByProperty indexes the attributes of a poll by property id.
+func attributesByProperty(poll *l8tpollaris.L8Poll) map[string]*l8tpollaris.L8PAttribute {
+	result := make(map[string]*l8tpollaris.L8PAttribute, len(poll.Attributes))
+	for _, attr := range poll.Attributes {
+		result[attr.PropertyId] = attr
+	}
+	return result
+}
+
+// sortedCopy returns a sorted copy of the list.
+func sortedCopy(list []string) []string {
+	result := append([]string{}, list...)
+	sort.Strings(result)
+	return result
+}
+
+// enumString renders an enum value, or an empty string when it is unset.
+func enumString(value int32, name string) string {
+	if value == 0 {
+		return ""
+	}
+	return name
+}
+
+// int64String renders a number, or an empty string when it is zero.
+func int64String(value int64) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.FormatInt(value, 10)
+}
+
+// int64sString renders a list of numbers as "[1 2 3]", or an empty string
+// when the list is empty.
+func int64sString(values []int64) string {
+	if len(values) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		parts = append(parts, strconv.FormatInt(value, 10))
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// pollString renders a poll as "protocol operation what".
+func pollString(poll *l8tpollaris.L8Poll) string {
+	return strings.TrimSpace(enumString(int32(poll.Protocol), poll.Protocol.String()) + " " +
+		enumString(int32(poll.Operation), poll.Operation.String()) + " " + poll.What)
+}
+
+// cadenceString renders a cadence plan.
+func cadenceString(cadence *l8tpollaris.L8PCadencePlan) string {
+	return "cadences=" + int64sString(cadence.Cadences) + " startups=" + int64sString(cadence.Startups) +
+		" enabled=" + strconv.FormatBool(cadence.Enabled)
+}
+
+// ruleString renders a rule as "name(key=value, ...)" with sorted parameters.
+func ruleString(rule *l8tpollaris.L8PRule) string {
+	keys := make([]string, 0, len(rule.Params))
+	for key := range rule.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := ""
+		if rule.Params[key] != nil {
+			value = rule.Params[key].Value
+		}
+		params = append(params, key+"="+value)
+	}
+	return rule.Name + "(" + strings.Join(params, ", ") + ")"
+}
+
+// rulesString renders a list of rules separated by " | ".
+func rulesString(rules []*l8tpollaris.L8PRule) string {
+	parts := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		parts = append(parts, ruleString(rule))
+	}
+	return strings.Join(parts, " | ")
+}
@@ -0,0 +1,370 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// ModelsDirectory is an optional directory of L8Pollaris definitions in JSON
+// (.json) or YAML (.yaml, .yml) files. When set before Activate, the models in
+// the directory are loaded when the service is activated, and the directory
+// is watched so that added, edited and removed files are posted, put and
+// deleted in the PollarisCenter at runtime. Each file holds either a single
+// L8Pollaris, a L8PollarisList or a L8PollarisGroupDefinition, whose nesting
+// is defined when the file is loaded and removed when the file is removed.
+var ModelsDirectory string
+
+// ModelsReloadInterval is how often the ModelsDirectory is checked for changes.
+var ModelsReloadInterval = time.Second * 10
+
+// watchedFile records the state of a models file as last loaded.
+type watchedFile struct {
+	// modTime is the file modification time when last loaded
+	modTime time.Time
+	// size is the file size when last loaded
+	size int64
+	// names are the pollaris names defined by the file
+	names []string
+	// groups are the names of the groups defined by the file
+	groups []string
+}
+
+// modelsWatcher keeps a PollarisCenter in sync with a models directory.
+type modelsWatcher struct {
+	// dir is the watched directory
+	dir string
+	// center receives the loaded models
+	center *PollarisCenter
+	// log reports load errors
+	log ifs.ILogger
+	// files is the state of each models file, by path
+	files map[string]*watchedFile
+	// orphans are the pollaris names no longer defined by the file of the
+	// given path and not yet deleted
+	orphans map[string]string
+	// orphanGroups are the group names no longer defined by the file of the
+	// given path and not yet removed
+	orphanGroups map[string]string
+	// done is closed to stop watching
+	done chan bool
+	// once guards closing done
+	once *sync.Once
+}
+
+// newModelsWatcher creates a watcher of the directory for the given center.
+func newModelsWatcher(dir string, center *PollarisCenter, resources ifs.IResources) *modelsWatcher {
+	return &modelsWatcher{dir: dir, center: center, log: resources.Logger(),
+		files: make(map[string]*watchedFile), orphans: make(map[string]string),
+		orphanGroups: make(map[string]string), done: make(chan bool), once: &sync.Once{}}
+}
+
+// watch checks the directory for changes every ModelsReloadInterval until stop is called.
+func (this *modelsWatcher) watch() {
+	ticker := time.NewTicker(ModelsReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-this.done:
+			return
+		case <-ticker.C:
+			this.scan()
+		}
+	}
+}
+
+// stop stops watching the directory.
+func (this *modelsWatcher) stop() {
+	this.once.Do(func() {
+		close(this.done)
+	})
+}
+
+// scan loads new and modified files into the center and deletes the models
+// of files that were removed, or that no longer define them. A file that
+// fails to load is reported and its previously loaded models are kept, and
+// so is the previously loaded definition of a model that fails to apply,
+// e.g. when an edit makes it invalid. A model or a group is deleted only
+// once every file is loaded and none defines it, so moving it to another
+// file keeps it, and its deletion is retried on the next scan if it fails.
+func (this *modelsWatcher) scan() {
+	entries, err := os.ReadDir(this.dir)
+	if err != nil {
+		this.log.Error("Cannot read models directory ", this.dir, ": ", err.Error())
+		return
+	}
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.IsDir() || !isModelsFile(entry.Name()) {
+			continue
+		}
+		path := filepath.Join(this.dir, entry.Name())
+		seen[path] = true
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		file, ok := this.files[path]
+		if ok && file.modTime.Equal(info.ModTime()) && file.size == info.Size() {
+			continue
+		}
+		if !ok {
+			file = &watchedFile{}
+			this.files[path] = file
+		}
+		file.modTime = info.ModTime()
+		file.size = info.Size()
+
+		models, definitions, err := loadModelsFile(path)
+		if err != nil {
+			this.log.Error("Cannot load models file ", path, ": ", err.Error())
+			continue
+		}
+		names := make([]string, 0, len(models))
+		for _, model := range models {
+			err = this.apply(model)
+			if err != nil {
+				this.log.Error("Cannot load pollaris ", model.Name, " from ", path, ": ", err.Error())
+				if contains(file.names, model.Name) {
+					names = append(names, model.Name)
+				}
+				continue
+			}
+			names = append(names, model.Name)
+		}
+		for _, name := range file.names {
+			if !contains(names, name) {
+				this.orphans[name] = path
+			}
+		}
+		file.names = names
+
+		groups := make([]string, 0, len(definitions))
+		for _, definition := range definitions {
+			err = this.applyGroup(definition)
+			if err != nil {
+				this.log.Error("Cannot load group ", definition.Group, " from ", path, ": ", err.Error())
+			}
+			if err == nil || contains(file.groups, definition.Group) {
+				groups = append(groups, definition.Group)
+			}
+		}
+		for _, group := range file.groups {
+			if !contains(groups, group) {
+				this.orphanGroups[group] = path
+			}
+		}
+		file.groups = groups
+	}
+	for path, file := range this.files {
+		if seen[path] {
+			continue
+		}
+		for _, name := range file.names {
+			this.orphans[name] = path
+		}
+		for _, group := range file.groups {
+			this.orphanGroups[group] = path
+		}
+		delete(this.files, path)
+	}
+	this.removeOrphans()
+}
+
+// removeOrphans deletes the orphan models and removes the orphan groups that
+// no file defines anymore. An orphan defined by a file is no longer an
+// orphan, and one whose deletion fails stays an orphan.
+func (this *modelsWatcher) removeOrphans() {
+	names := make(map[string]bool)
+	groups := make(map[string]bool)
+	for _, file := range this.files {
+		for _, name := range file.names {
+			names[name] = true
+		}
+		for _, group := range file.groups {
+			groups[group] = true
+		}
+	}
+	for name, path := range this.orphans {
+		if names[name] || this.remove(name, path) {
+			delete(this.orphans, name)
+		}
+	}
+	for group, path := range this.orphanGroups {
+		if groups[group] || this.removeGroup(group, path) {
+			delete(this.orphanGroups, group)
+		}
+	}
+}
+
+// apply posts the model if it is new, or puts it if it differs from the
+// definition already in the center.
+func (this *modelsWatcher) apply(model *l8tpollaris.L8Pollaris) error {
+	existing := this.center.Definition(model.Name)
+	if existing == nil {
+		this.log.Info("Loading pollaris ", model.Name, " from ", this.dir)
+		return this.center.Post(model, false)
+	}
+	if sameDefinition(existing, model) {
+		return nil
+	}
+	this.log.Info("Reloading pollaris ", model.Name, " from ", this.dir)
+	return this.center.Put(model, false)
+}
+
+// remove deletes a model that is no longer defined by the given file and
+// returns true if it was deleted.
+func (this *modelsWatcher) remove(name, path string) bool {
+	this.log.Info("Removing pollaris ", name, " no longer defined in ", path)
+	err := this.center.Delete(&l8tpollaris.L8Pollaris{Name: name}, false)
+	if err != nil {
+		this.log.Error(err.Error())
+		return false
+	}
+	return true
+}
+
+// applyGroup defines the group if its definition differs from the one
+// already in the center.
+func (this *modelsWatcher) applyGroup(definition *l8tpollaris.L8PollarisGroupDefinition) error {
+	existing := this.center.GroupDefinition(definition.Group)
+	if existing != nil && sameIncludes(existing, definition) {
+		return nil
+	}
+	this.log.Info("Loading group ", definition.Group, " from ", this.dir)
+	return this.center.DefineGroup(definition, false)
+}
+
+// removeGroup removes the nesting of a group that is no longer defined by
+// the given file and returns true if it was removed.
+func (this *modelsWatcher) removeGroup(group, path string) bool {
+	this.log.Info("Removing group ", group, " no longer defined in ", path)
+	err := this.center.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: group}, false)
+	if err != nil {
+		this.log.Error(err.Error())
+		return false
+	}
+	return true
+}
+
+// sameDefinition reports whether two definitions are equal, ignoring the
+// revision and the modification stamp.
+func sameDefinition(a, b *l8tpollaris.L8Pollaris) bool {
+	ac := proto.Clone(a).(*l8tpollaris.L8Pollaris)
+	bc := proto.Clone(b).(*l8tpollaris.L8Pollaris)
+	for _, c := range []*l8tpollaris.L8Pollaris{ac, bc} {
+		c.Revision = 0
+		c.Modified = 0
+		c.Origin = ""
+	}
+	return proto.Equal(ac, bc)
+}
+
+// isModelsFile reports whether the file name has a JSON or YAML extension.
+func isModelsFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// LoadModelsFile reads the L8Pollaris definitions from a JSON or YAML file.
+// The file holds either a single L8Pollaris or a L8PollarisList; a file
+// holding a L8PollarisGroupDefinition defines no pollarises.
+func LoadModelsFile(path string) ([]*l8tpollaris.L8Pollaris, error) {
+	models, _, err := loadModelsFile(path)
+	return models, err
+}
+
+// loadModelsFile reads the L8Pollaris definitions and the group definitions
+// from a JSON or YAML file, see unmarshalModels.
+func loadModelsFile(path string) ([]*l8tpollaris.L8Pollaris, []*l8tpollaris.L8PollarisGroupDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if strings.ToLower(filepath.Ext(path)) != ".json" {
+		data, err = yaml.YAMLToJSON(data)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return unmarshalModels(data)
+}
+
+// LoadModelsDirectory reads the L8Pollaris definitions from every JSON or
+// YAML file in the directory, in file name order.
+func LoadModelsDirectory(dir string) ([]*l8tpollaris.L8Pollaris, error) {
+	models, _, err := loadModelsDirectory(dir)
+	return models, err
+}
+
+// loadModelsDirectory reads the L8Pollaris definitions and the group
+// definitions from every JSON or YAML file in the directory, in file name order.
+func loadModelsDirectory(dir string) ([]*l8tpollaris.L8Pollaris, []*l8tpollaris.L8PollarisGroupDefinition, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && isModelsFile(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	result := make([]*l8tpollaris.L8Pollaris, 0)
+	definitions := make([]*l8tpollaris.L8PollarisGroupDefinition, 0)
+	for _, name := range names {
+		models, groups, err := loadModelsFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, nil, errors.New(name + ": " + err.Error())
+		}
+		result = append(result, models...)
+		definitions = append(definitions, groups...)
+	}
+	return result, definitions, nil
+}
+
+// unmarshalModels decodes JSON holding either a single L8Pollaris, a
+// L8PollarisList or a single L8PollarisGroupDefinition.
+func unmarshalModels(data []byte) ([]*l8tpollaris.L8Pollaris, []*l8tpollaris.L8PollarisGroupDefinition, error) {
+	single := &l8tpollaris.L8Pollaris{}
+	err := protojson.Unmarshal(data, single)
+	if err == nil {
+		return []*l8tpollaris.L8Pollaris{single}, nil, nil
+	}
+	list := &l8tpollaris.L8PollarisList{}
+	if protojson.Unmarshal(data, list) == nil {
+		return list.List, nil, nil
+	}
+	definition := &l8tpollaris.L8PollarisGroupDefinition{}
+	if protojson.Unmarshal(data, definition) != nil || definition.Group == "" {
+		return nil, nil, err
+	}
+	return nil, []*l8tpollaris.L8PollarisGroupDefinition{definition}, nil
+}
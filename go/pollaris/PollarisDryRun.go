@@ -0,0 +1,185 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// RuleEngine applies the parser rules of the attributes during a dry run.
+type RuleEngine interface {
+	// Apply runs the named rule with its parameters on the input, which is
+	// the recorded response for the first rule of an attribute and the output
+	// of the previous rule for the others, and returns the rule output.
+	Apply(name string, params map[string]*l8tpollaris.L8PParameter, input interface{}) (interface{}, error)
+}
+
+// DryRunRules overrides the engine running the parser rules during a dry
+// run. When nil, the rules implemented by the l8parser are run, see
+// ParserRules. This should be set by the application before calling Activate.
+var DryRunRules RuleEngine
+
+// DryRun runs every poll of the pollaris against the recorded responses with
+// the rule engine and returns the outcome of each poll, in poll name order. A poll is answered by
+// the response with its protocol and What, or by a response with its What and
+// no protocol. Each attribute runs its rules in order on the response, the
+// output of a rule being the input of the next, and reports the resulting
+// value or the first rule failure. An attribute without rules reports the
+// response as is.
+func DryRun(l8pollaris *l8tpollaris.L8Pollaris, responses []*l8tpollaris.L8PRecordedResponse, engine RuleEngine) []*l8tpollaris.L8PDryRunPoll {
+	result := make([]*l8tpollaris.L8PDryRunPoll, 0, len(l8pollaris.Polling))
+	for _, pollName := range sortedPollNames(l8pollaris) {
+		poll := l8pollaris.Polling[pollName]
+		outcome := &l8tpollaris.L8PDryRunPoll{PollName: pollName, Protocol: poll.Protocol, What: poll.What,
+			Values: make([]*l8tpollaris.L8PDryRunValue, 0, len(poll.Attributes))}
+		result = append(result, outcome)
+		response := responseOf(poll, responses)
+		if response == nil {
+			outcome.NoResponse = true
+			continue
+		}
+		for i, attr := range poll.Attributes {
+			value, err := applyRules(engine, attr.Rules, response.Data)
+			dryRunValue := &l8tpollaris.L8PDryRunValue{PropertyId: attr.PropertyId, Value: value}
+			if err != nil {
+				dryRunValue.Value = ""
+				dryRunValue.Error = pollPath(pollName) + ".attributes[" + strconv.Itoa(i) + "]." + err.Error()
+			}
+			outcome.Values = append(outcome.Values, dryRunValue)
+		}
+	}
+	return result
+}
+
+// responseOf returns the recorded response answering the poll, preferring a
+// response with the poll protocol, or nil if there is none.
+func responseOf(poll *l8tpollaris.L8Poll, responses []*l8tpollaris.L8PRecordedResponse) *l8tpollaris.L8PRecordedResponse {
+	var anyProtocol *l8tpollaris.L8PRecordedResponse
+	for _, response := range responses {
+		if response.What != poll.What {
+			continue
+		}
+		if response.Protocol == poll.Protocol {
+			return response
+		}
+		if response.Protocol == l8tpollaris.L8PProtocol_L8PInvalid_Protocol && anyProtocol == nil {
+			anyProtocol = response
+		}
+	}
+	return anyProtocol
+}
+
+// applyRules runs the rules in order on the value, through the engine. The
+// error of a failing rule is prefixed with its path, e.g. "rules[1] regex: ...".
+func applyRules(engine RuleEngine, rules []*l8tpollaris.L8PRule, value string) (string, error) {
+	var output interface{} = value
+	for i, rule := range rules {
+		prefix := "rules[" + strconv.Itoa(i) + "] " + rule.Name + ": "
+		next, err := engine.Apply(rule.Name, rule.Params, output)
+		if err != nil {
+			return "", errors.New(prefix + err.Error())
+		}
+		output = next
+	}
+	return valueString(output)
+}
+
+// valueString returns the text of a rule output: a string as is, a message
+// as JSON and any other value in its default format.
+func valueString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case proto.Message:
+		data, err := protojson.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	return fmt.Sprint(value), nil
+}
+
+// DryRunOf resolves the pollaris of a dry run request, the inline one or the
+// effective stored one, runs it and returns the response with the results.
+// The inline pollaris is validated like a posted one, and the polls it
+// inherits through Extends are resolved from the stored pollarises.
+// The rules are run by DryRunRules, or by the l8parser rules by default.
+// Returns an error if neither is set, the inline pollaris is invalid or the
+// pollaris cannot be resolved.
+func (this *PollarisCenter) DryRunOf(request *l8tpollaris.L8PollarisDryRun) (*l8tpollaris.L8PollarisDryRun, error) {
+	var l8pollaris *l8tpollaris.L8Pollaris
+	var err error
+	if request.Pollaris != nil {
+		err = this.validate(request.Pollaris)
+		if err != nil {
+			return nil, err
+		}
+		l8pollaris, err = this.effectiveOf(request.Pollaris)
+	} else {
+		if request.Name == "" {
+			return nil, errors.New("Dry run does not contain a Pollaris or a Name")
+		}
+		l8pollaris, err = this.Effective(request.Name)
+		if err == nil && l8pollaris == nil {
+			err = errors.New("Cannot find Pollaris " + request.Name)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &l8tpollaris.L8PollarisDryRun{Pollaris: l8pollaris, Name: request.Name,
+		Results: DryRun(l8pollaris, request.Responses, this.ruleEngine())}, nil
+}
+
+// ruleEngine returns the engine of the dry runs, DryRunRules when it is set
+// or the l8parser rules set at Activate.
+func (this *PollarisCenter) ruleEngine() RuleEngine {
+	if DryRunRules != nil {
+		return DryRunRules
+	}
+	return this.parserRules
+}
+
+// ReadDryRun reads a dry run request, typically the model and its recorded
+// responses, from a JSON file, or a YAML file for any other extension, so
+// model authors can keep them next to the models.
+func ReadDryRun(path string) (*l8tpollaris.L8PollarisDryRun, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ToLower(filepath.Ext(path)) != ".json" {
+		data, err = yaml.YAMLToJSON(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	request := &l8tpollaris.L8PollarisDryRun{}
+	err = protojson.Unmarshal(data, request)
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
@@ -0,0 +1,241 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/proto"
+)
+
+// Listener is an in-process callback receiving the pollaris change events.
+// Listeners are called synchronously, in subscription name order, after the
+// change is applied, so they should return quickly.
+type Listener func(event *l8tpollaris.L8PollarisEvent)
+
+// subscriptionKey returns the key of a service subscription.
+func subscriptionKey(serviceName string, serviceArea int32) string {
+	return serviceName + "+" + strconv.Itoa(int(serviceArea))
+}
+
+// Subscribe adds, or replaces, a named in-process listener. Listeners receive
+// the events of every change applied to this center, including the changes
+// received from peers.
+func (this *PollarisCenter) Subscribe(name string, listener Listener) {
+	this.subsMtx.Lock()
+	defer this.subsMtx.Unlock()
+	this.listeners[name] = listener
+}
+
+// Unsubscribe removes a named in-process listener.
+func (this *PollarisCenter) Unsubscribe(name string) {
+	this.subsMtx.Lock()
+	defer this.subsMtx.Unlock()
+	delete(this.listeners, name)
+}
+
+// SubscribeService subscribes a service to the change events, which are
+// posted to it as L8PollarisEvent. Only the instance where a change
+// originates sends its event, so a service should multicast its
+// subscription to all the Pollaris instances.
+func (this *PollarisCenter) SubscribeService(subscription *l8tpollaris.L8PollarisSubscription) error {
+	if subscription == nil || subscription.ServiceName == "" {
+		return errors.New("Subscription does not contain a ServiceName")
+	}
+	this.subsMtx.Lock()
+	defer this.subsMtx.Unlock()
+	key := subscriptionKey(subscription.ServiceName, subscription.ServiceArea)
+	this.subscriptions[key] = proto.Clone(subscription).(*l8tpollaris.L8PollarisSubscription)
+	return nil
+}
+
+// UnsubscribeService removes the subscription of a service.
+func (this *PollarisCenter) UnsubscribeService(serviceName string, serviceArea int32) {
+	this.subsMtx.Lock()
+	defer this.subsMtx.Unlock()
+	delete(this.subscriptions, subscriptionKey(serviceName, serviceArea))
+}
+
+// hasSubscribers reports whether there are listeners or subscribed services.
+func (this *PollarisCenter) hasSubscribers() bool {
+	this.subsMtx.RLock()
+	defer this.subsMtx.RUnlock()
+	return len(this.listeners) > 0 || len(this.subscriptions) > 0
+}
+
+// previousLocked returns a copy of the stored definition of the pollaris, to be
+// reported as the old definition of the change, or nil when it does not exist.
+// It is captured for every change, even when there is no one to notify yet,
+// so a subscriber added while the change is applied receives the right event.
+// Caller must hold this.mtx write lock.
+func (this *PollarisCenter) previousLocked(name string) *l8tpollaris.L8Pollaris {
+	existing := this.Definition(name)
+	if existing == nil {
+		return nil
+	}
+	return proto.Clone(existing).(*l8tpollaris.L8Pollaris)
+}
+
+// publish delivers the event of a change from previous to current, where a
+// nil previous means added and a nil current means removed. Listeners receive
+// every event, while subscribed services only receive the events of local changes.
+func (this *PollarisCenter) publish(previous, current *l8tpollaris.L8Pollaris, isNotification bool) {
+	if !this.hasSubscribers() {
+		return
+	}
+	event := &l8tpollaris.L8PollarisEvent{Old: previous, Type: l8tpollaris.L8PEventType_L8PEvent_Updated}
+	if current != nil {
+		event.New = proto.Clone(current).(*l8tpollaris.L8Pollaris)
+	}
+	switch {
+	case previous == nil:
+		event.Type = l8tpollaris.L8PEventType_L8PEvent_Added
+		event.Name = current.Name
+	case current == nil:
+		event.Type = l8tpollaris.L8PEventType_L8PEvent_Removed
+		event.Name = previous.Name
+	default:
+		event.Name = current.Name
+	}
+	event.Affected = this.descendantsOf(event.Name)
+	this.deliver(event, isNotification)
+}
+
+// publishGroup delivers the event of a change of a group definition from
+// previous to current, where a definition without includes means the group
+// is not nested, so defining it is added and clearing it is removed. The
+// members are the pollarises in the group before the change, reported as
+// affected with the ones in the group after it. Nothing is published when
+// the includes did not change.
+func (this *PollarisCenter) publishGroup(previous, current *l8tpollaris.L8PollarisGroupDefinition, members []string, isNotification bool) {
+	if previous == nil {
+		previous = &l8tpollaris.L8PollarisGroupDefinition{Group: current.Group}
+	}
+	if sameIncludes(previous, current) || !this.hasSubscribers() {
+		return
+	}
+	event := &l8tpollaris.L8PollarisEvent{Group: current.Group, Type: l8tpollaris.L8PEventType_L8PEvent_Updated}
+	if len(previous.Includes) > 0 {
+		event.OldGroup = proto.Clone(previous).(*l8tpollaris.L8PollarisGroupDefinition)
+	}
+	if len(current.Includes) > 0 {
+		event.NewGroup = proto.Clone(current).(*l8tpollaris.L8PollarisGroupDefinition)
+	}
+	switch {
+	case event.OldGroup == nil:
+		event.Type = l8tpollaris.L8PEventType_L8PEvent_Added
+	case event.NewGroup == nil:
+		event.Type = l8tpollaris.L8PEventType_L8PEvent_Removed
+	}
+	after, _ := this.expandGroup(current.Group)
+	for _, name := range after {
+		if !contains(members, name) {
+			members = append(members, name)
+		}
+	}
+	sort.Strings(members)
+	event.Affected = members
+	this.deliver(event, isNotification)
+}
+
+// deliver sends the event to the listeners, and to the subscribed services
+// it matches when the change is local.
+func (this *PollarisCenter) deliver(event *l8tpollaris.L8PollarisEvent, isNotification bool) {
+	this.subsMtx.RLock()
+	names := make([]string, 0, len(this.listeners))
+	for name := range this.listeners {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	listeners := make([]Listener, 0, len(names))
+	for _, name := range names {
+		listeners = append(listeners, this.listeners[name])
+	}
+	subscriptions := make([]*l8tpollaris.L8PollarisSubscription, 0, len(this.subscriptions))
+	for _, subscription := range this.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	this.subsMtx.RUnlock()
+
+	for _, listener := range listeners {
+		listener(event)
+	}
+	if isNotification || this.vnic == nil {
+		return
+	}
+	for _, subscription := range subscriptions {
+		if !subscribedTo(subscription, event) {
+			continue
+		}
+		err := this.vnic.Multicast(subscription.ServiceName, byte(subscription.ServiceArea), ifs.POST, event)
+		if err != nil {
+			this.log.Error("Cannot send pollaris event to ", subscription.ServiceName, ": ", err.Error())
+		}
+	}
+}
+
+// subscribedTo reports whether the event matches the names and groups of the
+// subscription, considering the groups of both the old and new definitions,
+// and for a group change the group itself.
+func subscribedTo(subscription *l8tpollaris.L8PollarisSubscription, event *l8tpollaris.L8PollarisEvent) bool {
+	if len(subscription.Names) == 0 && len(subscription.Groups) == 0 {
+		return true
+	}
+	if contains(subscription.Names, event.Name) || contains(subscription.Groups, event.Group) {
+		return true
+	}
+	for _, affected := range event.Affected {
+		if contains(subscription.Names, affected) {
+			return true
+		}
+	}
+	for _, l8pollaris := range []*l8tpollaris.L8Pollaris{event.Old, event.New} {
+		if l8pollaris != nil && len(subscription.Groups) > 0 && inGroups(l8pollaris, subscription.Groups) {
+			return true
+		}
+	}
+	return false
+}
+
+// descendantsOf returns the names of the pollarises extending the named one,
+// directly or through other pollarises, sorted. The chains are followed up to
+// a missing base or a cycle, so the descendants of a removed pollaris are
+// found as well.
+func (this *PollarisCenter) descendantsOf(name string) []string {
+	result := make([]string, 0)
+	for _, other := range this.AllNames() {
+		definition := this.Definition(other)
+		if other == name || definition == nil {
+			continue
+		}
+		visited := map[string]bool{other: true}
+		for base := definition.Extends; base != "" && !visited[base]; {
+			if base == name {
+				result = append(result, other)
+				break
+			}
+			visited[base] = true
+			definition = this.resolveDefinition(base)
+			if definition == nil {
+				break
+			}
+			base = definition.Extends
+		}
+	}
+	return result
+}
@@ -0,0 +1,316 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefineGroup sets the groups a group includes, replacing its previous
+// includes, so the group expands to the pollarises of the included groups as
+// well. A definition without includes removes the nesting of the group.
+// Groups may include groups that have no members yet. A tenant definition
+// shadows the global definition of the same group.
+// Like the pollarises, the definitions are replicated to the peers in the
+// Synced mode, a notification older than the stored definition is ignored,
+// and every definition is saved to the PollarisStore when it is a GroupStore.
+// A change of the includes is published to the subscribers, see Subscribe.
+// Returns an error if the group has no name, the includes form a cycle or
+// the definition cannot be persisted.
+func (this *PollarisCenter) DefineGroup(definition *l8tpollaris.L8PollarisGroupDefinition, isNotification bool) error {
+	if definition == nil || definition.Group == "" {
+		return errors.New("Group definition does not contain a Group")
+	}
+	stored := &l8tpollaris.L8PollarisGroupDefinition{Group: definition.Group, Includes: includesOf(definition),
+		Modified: definition.Modified, Origin: definition.Origin}
+	members, _ := this.expandGroup(stored.Group)
+
+	this.mtx.Lock()
+	if this.staleGroupLocked(stored, isNotification) {
+		this.mtx.Unlock()
+		return nil
+	}
+	err := this.checkIncludesLocked(stored)
+	if err != nil {
+		this.mtx.Unlock()
+		return err
+	}
+	if !isNotification || stored.Modified == 0 {
+		stored.Modified = time.Now().UnixNano()
+		stored.Origin = this.localUuid()
+	}
+	previous := this.includes[stored.Group]
+	this.includes[stored.Group] = stored
+	this.mtx.Unlock()
+
+	this.group2Def.Post(stored, isNotification)
+	this.publishGroup(previous, stored, members, isNotification)
+	return this.persistGroup(stored)
+}
+
+// includesOf returns the sorted names of the groups the definition
+// includes, without empty names and duplicates.
+func includesOf(definition *l8tpollaris.L8PollarisGroupDefinition) []string {
+	includes := make([]string, 0, len(definition.Includes))
+	for _, included := range definition.Includes {
+		if included != "" && !contains(includes, included) {
+			includes = append(includes, included)
+		}
+	}
+	sort.Strings(includes)
+	return includes
+}
+
+// sameIncludes reports whether two group definitions include the same groups.
+func sameIncludes(a, b *l8tpollaris.L8PollarisGroupDefinition) bool {
+	return strings.Join(includesOf(a), "\n") == strings.Join(includesOf(b), "\n")
+}
+
+// checkIncludesLocked returns an error if the includes of the definition
+// would form a cycle with the stored definitions.
+// Caller must hold this.mtx lock.
+func (this *PollarisCenter) checkIncludesLocked(definition *l8tpollaris.L8PollarisGroupDefinition) error {
+	for _, included := range definition.Includes {
+		chain := this.includeChainLocked(included, definition.Group, []string{definition.Group}, make(map[string]bool))
+		if chain != nil {
+			return errors.New("Group " + definition.Group + " cannot include " + included +
+				", the groups form a cycle " + strings.Join(chain, " -> "))
+		}
+	}
+	return nil
+}
+
+// staleGroupLocked reports whether a definition received from a peer is
+// older than, or loses the conflict with, the stored definition of the
+// group, and should be ignored. Local definitions are never stale.
+// Caller must hold this.mtx write lock.
+func (this *PollarisCenter) staleGroupLocked(definition *l8tpollaris.L8PollarisGroupDefinition, isNotification bool) bool {
+	if !isNotification || definition.Modified == 0 {
+		return false
+	}
+	existing, ok := this.includes[definition.Group]
+	if !ok || this.newerStamp(definition.Modified, definition.Origin, existing.Modified, existing.Origin) {
+		return false
+	}
+	this.log.Info("Ignoring stale definition of group ", definition.Group, " from ", definition.Origin)
+	return true
+}
+
+// GroupDefinition returns a copy of the stored definition of the group, or
+// nil if the group was never defined in this center.
+func (this *PollarisCenter) GroupDefinition(groupName string) *l8tpollaris.L8PollarisGroupDefinition {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	definition, ok := this.includes[groupName]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(definition).(*l8tpollaris.L8PollarisGroupDefinition)
+}
+
+// GroupSnapshot returns copies of all the group definitions of the center,
+// in group name order, including the definitions without includes, so a
+// peer merging them does not restore a removed nesting.
+func (this *PollarisCenter) GroupSnapshot() []*l8tpollaris.L8PollarisGroupDefinition {
+	this.mtx.RLock()
+	result := make([]*l8tpollaris.L8PollarisGroupDefinition, 0, len(this.includes))
+	for _, definition := range this.includes {
+		result = append(result, proto.Clone(definition).(*l8tpollaris.L8PollarisGroupDefinition))
+	}
+	this.mtx.RUnlock()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Group < result[j].Group
+	})
+	return result
+}
+
+// MergeGroups applies the group definitions of a peer snapshot as
+// notifications, so each of them replaces the local definition only if it
+// is newer. Returns the first error encountered, after merging all the
+// definitions.
+func (this *PollarisCenter) MergeGroups(list []*l8tpollaris.L8PollarisGroupDefinition) error {
+	var result error
+	for _, definition := range list {
+		err := this.DefineGroup(definition, true)
+		if err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
+
+// loadGroups restores the group definitions persisted in the store, when it
+// is a GroupStore, and returns them as the init items of the group cache.
+// A definition that fails to load, e.g. because it forms a cycle with the
+// ones loaded before it, is reported and skipped.
+func (this *PollarisCenter) loadGroups() []interface{} {
+	groupStore, ok := this.store.(GroupStore)
+	if !ok {
+		return nil
+	}
+	persisted, err := groupStore.LoadGroups()
+	if err != nil {
+		this.log.Error("Cannot load persisted group definitions: ", err.Error())
+		return nil
+	}
+	this.log.Info("Loaded persisted group definitions ", len(persisted))
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	result := make([]interface{}, 0, len(persisted))
+	for _, definition := range persisted {
+		if definition.Group == "" {
+			continue
+		}
+		definition.Includes = includesOf(definition)
+		err = this.checkIncludesLocked(definition)
+		if err != nil {
+			this.log.Error("Cannot load persisted group definition: ", err.Error())
+			continue
+		}
+		this.includes[definition.Group] = definition
+		result = append(result, definition)
+	}
+	return result
+}
+
+// persistGroup saves the group definition to the store, if it is a GroupStore.
+func (this *PollarisCenter) persistGroup(definition *l8tpollaris.L8PollarisGroupDefinition) error {
+	groupStore, ok := this.store.(GroupStore)
+	if !ok {
+		return nil
+	}
+	err := groupStore.SaveGroup(definition)
+	if err != nil {
+		this.log.Error("Cannot persist definition of group ", definition.Group, ": ", err.Error())
+		return errors.New("Group " + definition.Group + " was defined but not persisted: " + err.Error())
+	}
+	return nil
+}
+
+// includeChainLocked returns the chain of includes from the group to the
+// target group, appended to chain, or nil if the group does not lead to it.
+// Caller must hold this.mtx lock.
+func (this *PollarisCenter) includeChainLocked(group, target string, chain []string, visited map[string]bool) []string {
+	chain = append(chain, group)
+	if group == target {
+		return chain
+	}
+	if visited[group] {
+		return nil
+	}
+	visited[group] = true
+	for _, included := range this.includes[group].GetIncludes() {
+		found := this.includeChainLocked(included, target, chain, visited)
+		if found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// GroupIncludes returns the names of the groups the group includes directly,
+// sorted. A tenant center falls back to the global definition of the group
+// when it does not nest the group itself.
+func (this *PollarisCenter) GroupIncludes(groupName string) []string {
+	this.mtx.RLock()
+	includes := this.includes[groupName].GetIncludes()
+	this.mtx.RUnlock()
+	if len(includes) > 0 {
+		return sortedCopy(includes)
+	}
+	if global := this.global(); global != nil {
+		return global.GroupIncludes(groupName)
+	}
+	return []string{}
+}
+
+// GroupTree returns the resolved membership tree of the group: its direct
+// members and the trees of the groups it includes. A group found again
+// below itself, which can only happen when a tenant nesting meets the global
+// one, is listed with its members but is not expanded again.
+func (this *PollarisCenter) GroupTree(groupName string) *l8tpollaris.L8PollarisGroupTree {
+	return this.groupTree(groupName, make(map[string]bool))
+}
+
+// groupTree returns the membership tree of the group, where ancestors are
+// the groups on the path from the root.
+func (this *PollarisCenter) groupTree(groupName string, ancestors map[string]bool) *l8tpollaris.L8PollarisGroupTree {
+	members, _ := this.directMembers(groupName)
+	sort.Strings(members)
+	tree := &l8tpollaris.L8PollarisGroupTree{Group: groupName, Members: members}
+	if ancestors[groupName] {
+		return tree
+	}
+	ancestors[groupName] = true
+	for _, included := range this.GroupIncludes(groupName) {
+		tree.Groups = append(tree.Groups, this.groupTree(included, ancestors))
+	}
+	delete(ancestors, groupName)
+	return tree
+}
+
+// expandGroup returns the names of the pollarises in the group and in the
+// groups it includes recursively, each once, and whether any of these groups
+// has members. Every group is expanded once, so the expansion ends even on
+// a cycle formed by a tenant nesting and the global one.
+func (this *PollarisCenter) expandGroup(groupName string) ([]string, bool) {
+	members := make([]string, 0)
+	found := false
+	seen := make(map[string]bool)
+	expanded := make(map[string]bool)
+	pending := []string{groupName}
+	for len(pending) > 0 {
+		group := pending[0]
+		pending = pending[1:]
+		if expanded[group] {
+			continue
+		}
+		expanded[group] = true
+		direct, ok := this.directMembers(group)
+		found = found || ok
+		for _, name := range direct {
+			if !seen[name] {
+				seen[name] = true
+				members = append(members, name)
+			}
+		}
+		pending = append(pending, this.GroupIncludes(group)...)
+	}
+	return members, found
+}
+
+// directMembers returns the names of the pollarises directly in the group,
+// and whether the group exists. A tenant center also returns the members of
+// the global group that it does not shadow with a pollaris of the same name.
+func (this *PollarisCenter) directMembers(groupName string) ([]string, bool) {
+	this.mtx.RLock()
+	_, ok := this.groups[groupName]
+	this.mtx.RUnlock()
+	members := this.groupMembers(groupName)
+	if global := this.global(); global != nil {
+		for _, name := range global.groupMembers(groupName) {
+			if this.Definition(name) == nil {
+				members = append(members, name)
+				ok = true
+			}
+		}
+	}
+	return members, ok
+}
@@ -0,0 +1,111 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"sort"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+)
+
+// Groups returns the catalog of the groups of the center, in name order: the
+// groups with members and the groups including other groups, with their
+// member counts and includes. The catalog describes the indexes of this
+// center; a tenant center does not list the groups of the global area.
+func (this *PollarisCenter) Groups() []*l8tpollaris.L8PGroupInfo {
+	this.mtx.RLock()
+	names := make([]string, 0, len(this.groups)+len(this.includes))
+	for gName := range this.groups {
+		names = append(names, gName)
+	}
+	for gName, definition := range this.includes {
+		if _, ok := this.groups[gName]; !ok && len(definition.Includes) > 0 {
+			names = append(names, gName)
+		}
+	}
+	this.mtx.RUnlock()
+	sort.Strings(names)
+
+	result := make([]*l8tpollaris.L8PGroupInfo, 0, len(names))
+	for _, gName := range names {
+		members, _ := this.directMembers(gName)
+		expanded, _ := this.expandGroup(gName)
+		result = append(result, &l8tpollaris.L8PGroupInfo{Group: gName, Members: int32(len(members)),
+			Includes: this.GroupIncludes(gName), Total: int32(len(expanded))})
+	}
+	return result
+}
+
+// Index returns the reverse index of the pollarises of the center, in name
+// order: the groups each pollaris is in and the keys resolving to it. When
+// name is not empty, only the entry of the named pollaris is returned, or
+// none if it is not indexed.
+func (this *PollarisCenter) Index(name string) []*l8tpollaris.L8PIndexEntry {
+	this.mtx.RLock()
+	entries := make(map[string]*l8tpollaris.L8PIndexEntry)
+	entryOf := func(pollName string) *l8tpollaris.L8PIndexEntry {
+		entry, ok := entries[pollName]
+		if !ok {
+			entry = &l8tpollaris.L8PIndexEntry{Name: pollName, Groups: []string{}, Keys: []string{}}
+			entries[pollName] = entry
+		}
+		return entry
+	}
+	for key, pollName := range this.key2Name {
+		if name == "" || pollName == name {
+			entry := entryOf(pollName)
+			entry.Keys = append(entry.Keys, key)
+		}
+	}
+	for gName, gEntry := range this.groups {
+		for _, pollName := range gEntry {
+			if name == "" || pollName == name {
+				entry := entryOf(pollName)
+				if !contains(entry.Groups, gName) {
+					entry.Groups = append(entry.Groups, gName)
+				}
+			}
+		}
+	}
+	this.mtx.RUnlock()
+
+	names := make([]string, 0, len(entries))
+	for pollName := range entries {
+		names = append(names, pollName)
+	}
+	sort.Strings(names)
+	result := make([]*l8tpollaris.L8PIndexEntry, 0, len(names))
+	for _, pollName := range names {
+		entry := entries[pollName]
+		sort.Strings(entry.Groups)
+		sort.Strings(entry.Keys)
+		result = append(result, entry)
+	}
+	return result
+}
+
+// Keys returns a snapshot of the key table of the center, in key order, to
+// troubleshoot key lookups. See PollarisByKey.
+func (this *PollarisCenter) Keys() []*l8tpollaris.L8PKeyEntry {
+	this.mtx.RLock()
+	result := make([]*l8tpollaris.L8PKeyEntry, 0, len(this.key2Name))
+	for key, pollName := range this.key2Name {
+		result = append(result, &l8tpollaris.L8PKeyEntry{Key: key, Name: pollName})
+	}
+	this.mtx.RUnlock()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
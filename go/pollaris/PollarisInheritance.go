@@ -0,0 +1,163 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"google.golang.org/protobuf/proto"
+)
+
+// Effective returns the effective L8Pollaris for the given name, merging the
+// polls inherited through the Extends chain. Starting from the root of the
+// chain, each descendant adds or overrides polls by name and removes the polls
+// listed in its Excludes. Device attributes are taken from the most specific
+// definition that sets them, while Name, Groups, Revision and the modification
+// stamp are those of the requested pollaris. A pollaris that does not extend
+// another one is returned as stored, without copying.
+// A tenant center resolves the pollarises and bases it does not define from
+// the global ServiceArea.
+// Returns nil and no error if the pollaris does not exist, or an error if a
+// base pollaris is missing or the chain contains a cycle.
+func (this *PollarisCenter) Effective(name string) (*l8tpollaris.L8Pollaris, error) {
+	definition := this.resolveDefinition(name)
+	if definition == nil {
+		return nil, nil
+	}
+	return this.effectiveOf(definition)
+}
+
+// effectiveOf returns the effective pollaris of a definition, stored or not,
+// with its bases resolved from the stored pollarises. See Effective.
+func (this *PollarisCenter) effectiveOf(definition *l8tpollaris.L8Pollaris) (*l8tpollaris.L8Pollaris, error) {
+	if definition.Extends == "" {
+		return definition, nil
+	}
+	chain, err := this.chainOf(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	effective := proto.Clone(chain[len(chain)-1]).(*l8tpollaris.L8Pollaris)
+	if effective.Polling == nil {
+		effective.Polling = make(map[string]*l8tpollaris.L8Poll)
+	}
+	for i := len(chain) - 2; i >= 0; i-- {
+		derived := chain[i]
+		mergeString(&effective.Vendor, derived.Vendor)
+		mergeString(&effective.Series, derived.Series)
+		mergeString(&effective.Family, derived.Family)
+		mergeString(&effective.Software, derived.Software)
+		mergeString(&effective.Hardware, derived.Hardware)
+		mergeString(&effective.Version, derived.Version)
+		for pollName, poll := range derived.Polling {
+			effective.Polling[pollName] = proto.Clone(poll).(*l8tpollaris.L8Poll)
+		}
+		for _, pollName := range derived.Excludes {
+			delete(effective.Polling, pollName)
+		}
+	}
+	effective.Name = definition.Name
+	effective.Groups = append([]string{}, definition.Groups...)
+	effective.Revision = definition.Revision
+	effective.Modified = definition.Modified
+	effective.Origin = definition.Origin
+	effective.Extends = ""
+	effective.Excludes = nil
+	return effective, nil
+}
+
+// chainOf returns the inheritance chain of the given definition, starting
+// with the definition itself and ending with the root it extends.
+// Returns an error if a base pollaris is missing or a cycle is detected.
+func (this *PollarisCenter) chainOf(definition *l8tpollaris.L8Pollaris) ([]*l8tpollaris.L8Pollaris, error) {
+	chain := []*l8tpollaris.L8Pollaris{definition}
+	visited := map[string]bool{definition.Name: true}
+	current := definition
+	for current.Extends != "" {
+		if visited[current.Extends] {
+			return nil, errors.New("Pollaris " + definition.Name + ": inheritance cycle through " + current.Extends)
+		}
+		base := this.resolveDefinition(current.Extends)
+		if base == nil {
+			return nil, errors.New("Pollaris " + current.Name + ": cannot find extended Pollaris " + current.Extends)
+		}
+		visited[base.Name] = true
+		chain = append(chain, base)
+		current = base
+	}
+	return chain, nil
+}
+
+// checkExtends verifies that storing the given pollaris does not create an
+// inheritance cycle. A missing base pollaris is accepted, as models may be
+// loaded in any order; it is reported when the pollaris is resolved.
+func (this *PollarisCenter) checkExtends(l8pollaris *l8tpollaris.L8Pollaris) error {
+	if l8pollaris.Extends == "" {
+		return nil
+	}
+	if l8pollaris.Extends == l8pollaris.Name {
+		return errors.New("Pollaris " + l8pollaris.Name + ": cannot extend itself")
+	}
+	visited := map[string]bool{l8pollaris.Name: true}
+	next := l8pollaris.Extends
+	for next != "" {
+		if visited[next] {
+			return errors.New("Pollaris " + l8pollaris.Name + ": inheritance cycle through " + next)
+		}
+		visited[next] = true
+		base := this.resolveDefinition(next)
+		if base == nil {
+			return nil
+		}
+		next = base.Extends
+	}
+	return nil
+}
+
+// extendedByLocked returns the sorted names of the pollarises of the center
+// that directly extend the named one.
+// Caller must hold this.mtx lock.
+func (this *PollarisCenter) extendedByLocked(name string) []string {
+	result := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, other := range this.key2Name {
+		if seen[other] {
+			continue
+		}
+		seen[other] = true
+		definition := this.Definition(other)
+		if definition != nil && definition.Extends == name {
+			result = append(result, other)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// depthOf returns the number of pollarises the named one inherits from,
+// following Extends until a missing base or a cycle.
+func (this *PollarisCenter) depthOf(name string) int {
+	depth := 0
+	visited := map[string]bool{name: true}
+	definition := this.resolveDefinition(name)
+	for definition != nil && definition.Extends != "" && !visited[definition.Extends] {
+		visited[definition.Extends] = true
+		depth++
+		definition = this.resolveDefinition(definition.Extends)
+	}
+	return depth
+}
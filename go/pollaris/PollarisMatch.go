@@ -0,0 +1,417 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+)
+
+// attributes holds the device classification attributes in key order:
+// vendor, series, family, software, hardware and version.
+type attributes [6]string
+
+// attributesOf returns the device classification attributes of a pollaris.
+func attributesOf(l8pollaris *l8tpollaris.L8Pollaris) attributes {
+	return attributes{l8pollaris.Vendor, l8pollaris.Series, l8pollaris.Family,
+		l8pollaris.Software, l8pollaris.Hardware, l8pollaris.Version}
+}
+
+// match describes how well a pollaris applies to a device.
+type match struct {
+	// name is the pollaris name
+	name string
+	// matched is the number of pollaris attributes matching the device attributes
+	matched int
+	// specificity is a bitmask of the matched attributes, later attributes
+	// (e.g. version) being more specific than earlier ones (e.g. vendor)
+	specificity int
+	// literal is the number of attributes matched literally rather than by a pattern
+	literal int
+	// precisions are how narrowly each matched attribute matched, by attribute index
+	precisions [6]precision
+	// unverified is the number of pollaris attributes the device does not report
+	unverified int
+}
+
+// Tiers of precision, from the least to the most precise.
+const (
+	precisionWildcard = iota
+	precisionRange
+	precisionExact
+)
+
+// precision describes how narrowly a pollaris attribute matched the device value.
+type precision struct {
+	// tier is precisionExact, precisionRange or precisionWildcard
+	tier int
+	// width is the size of what the attribute matches within its tier,
+	// a smaller width being narrower
+	width float64
+	// span is the span of versions matched by a bounded version range, as the
+	// differences of the components of its bounds, a smaller span being
+	// narrower when the widths are equal, see spanOf
+	span []int
+}
+
+// narrower reports whether this precision is narrower than other: an exact
+// value over a range over a wildcard, and within a tier the smaller width.
+func (this precision) narrower(other precision) bool {
+	if this.tier != other.tier {
+		return this.tier > other.tier
+	}
+	if this.width != other.width {
+		return this.width < other.width
+	}
+	return this.span != nil && other.span != nil && compareVersions(this.span, other.span) < 0
+}
+
+// better reports whether this match should be preferred over other.
+// More matched attributes win, then more specific attributes. Then the
+// attributes are compared from the most specific one (version) to the least
+// specific one (vendor), the narrower match winning: an exact value over the
+// narrowest range over a wildcard, e.g. for version 17.4 the range
+// ">=17.3 <18" over "17.x". Finally fewer unverified attributes win, and the
+// name decides for a deterministic result.
+func (this *match) better(other *match) bool {
+	if this.matched != other.matched {
+		return this.matched > other.matched
+	}
+	if this.specificity != other.specificity {
+		return this.specificity > other.specificity
+	}
+	for i := versionIndex; i >= vendorIndex; i-- {
+		if this.precisions[i].narrower(other.precisions[i]) {
+			return true
+		}
+		if other.precisions[i].narrower(this.precisions[i]) {
+			return false
+		}
+	}
+	if this.unverified != other.unverified {
+		return this.unverified < other.unverified
+	}
+	return this.name < other.name
+}
+
+// matchAttributes scores the pollaris attributes against the device attributes.
+// An empty pollaris attribute matches any device, and an empty device attribute
+// is unknown and neither matches nor contradicts the pollaris. Pollaris
+// attributes may be patterns, see matchField.
+// Returns false if any attribute contradicts the device.
+func matchAttributes(name string, pollaris, device attributes) (*match, bool) {
+	m := &match{name: name}
+	for i := range pollaris {
+		if pollaris[i] == "" {
+			continue
+		}
+		if device[i] == "" {
+			m.unverified++
+			continue
+		}
+		ok, p := matchField(i, pollaris[i], device[i])
+		if !ok {
+			return nil, false
+		}
+		m.matched++
+		m.specificity |= 1 << uint(i)
+		m.precisions[i] = p
+		if p.tier == precisionExact {
+			m.literal++
+		}
+	}
+	return m, true
+}
+
+// matchField matches a single pollaris attribute against the device value.
+// Series, family and hardware accept glob patterns (e.g. "catalyst-9*"),
+// software accepts a regular expression enclosed in slashes (e.g. "/ios-?xe/"),
+// and version accepts a version range (e.g. "17.x" or ">=17.3 <18"), see
+// matchVersionRange. Any other value is compared literally, ignoring case.
+// Returns whether the value matched, and how narrowly. Globs and regular
+// expressions are wildcards, narrower the more literal characters they have.
+// Version ranges are ranges, and versions with a wildcard component are
+// wildcards, narrower the smaller the span of versions they match.
+func matchField(index int, pattern, value string) (bool, precision) {
+	if strings.EqualFold(pattern, value) {
+		return true, precision{tier: precisionExact}
+	}
+	switch index {
+	case seriesIndex, familyIndex, hardwareIndex:
+		if isGlob(pattern) {
+			ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(value))
+			return ok, precision{tier: precisionWildcard, width: -float64(literalLength(pattern))}
+		}
+	case softwareIndex:
+		if isRegex(pattern) {
+			re, err := compileRegex(pattern)
+			return err == nil && re.MatchString(value),
+				precision{tier: precisionWildcard, width: -float64(literalLength(pattern))}
+		}
+	case versionIndex:
+		if isVersionRange(pattern) {
+			return matchVersionRange(pattern, value)
+		}
+	}
+	return false, precision{}
+}
+
+// literalLength returns the number of characters of a pattern that are not
+// glob or regular expression meta characters.
+func literalLength(pattern string) int {
+	count := 0
+	for _, c := range pattern {
+		if !strings.ContainsRune("*?[]/\\^$|()+{}", c) {
+			count++
+		}
+	}
+	return count
+}
+
+// Indexes of the attributes in the attributes array.
+const (
+	vendorIndex = iota
+	seriesIndex
+	familyIndex
+	softwareIndex
+	hardwareIndex
+	versionIndex
+)
+
+// isGlob reports whether the attribute is a glob pattern.
+func isGlob(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
+
+// isRegex reports whether the attribute is a regular expression enclosed in slashes.
+func isRegex(pattern string) bool {
+	return len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/")
+}
+
+// regexCache caches compiled software patterns by their text.
+var regexCache = &sync.Map{}
+
+// compileRegex compiles a slash enclosed regular expression, anchored so it
+// must match the whole value. Compiled expressions are cached.
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	re, ok := regexCache.Load(pattern)
+	if ok {
+		return re.(*regexp.Regexp), nil
+	}
+	compiled, err := regexp.Compile("(?i)^(?:" + pattern[1:len(pattern)-1] + ")$")
+	if err != nil {
+		return nil, err
+	}
+	regexCache.Store(pattern, compiled)
+	return compiled, nil
+}
+
+// checkPatterns verifies that the patterns in the pollaris attributes are
+// well formed, so a bad pattern is rejected when posted rather than silently
+// never matching.
+func checkPatterns(l8pollaris *l8tpollaris.L8Pollaris) error {
+	attrs := attributesOf(l8pollaris)
+	for _, i := range []int{seriesIndex, familyIndex, hardwareIndex} {
+		if isGlob(attrs[i]) {
+			_, err := path.Match(attrs[i], "")
+			if err != nil {
+				return errors.New("Pollaris " + l8pollaris.Name + ": invalid glob " + attrs[i])
+			}
+		}
+	}
+	if isRegex(attrs[softwareIndex]) {
+		_, err := compileRegex(attrs[softwareIndex])
+		if err != nil {
+			return errors.New("Pollaris " + l8pollaris.Name + ": invalid software regex " + err.Error())
+		}
+	}
+	if isVersionRange(attrs[versionIndex]) {
+		_, err := parseVersionRange(attrs[versionIndex])
+		if err != nil {
+			return errors.New("Pollaris " + l8pollaris.Name + ": " + err.Error())
+		}
+	}
+	return nil
+}
+
+// deviceOf builds the device attributes from positional arguments in the
+// order vendor, series, family, software, hardware, version.
+func deviceOf(args []string) attributes {
+	device := attributes{}
+	for i := 0; i < len(args) && i < len(device); i++ {
+		device[i] = args[i]
+	}
+	return device
+}
+
+// bestVariant returns the name of the best matching variant of the named
+// pollaris for the device, or an empty string if none matches. The variants
+// are the pollaris itself and every pollaris extending it, directly or not.
+// Variants constraining an attribute the device does not report are skipped.
+// When trace is not nil, every variant considered is recorded as a candidate.
+func (this *PollarisCenter) bestVariant(name string, device attributes, trace *l8tpollaris.L8PollarisExplain) string {
+	var best *match
+	candidates := make(map[string]*l8tpollaris.L8PExplainCandidate)
+	for _, variant := range this.variantsOf(name) {
+		candidate := &l8tpollaris.L8PExplainCandidate{Name: variant}
+		if trace != nil {
+			trace.Candidates = append(trace.Candidates, candidate)
+			candidates[variant] = candidate
+		}
+		effective, err := this.Effective(variant)
+		if err != nil || effective == nil {
+			candidate.Reason = "cannot resolve pollaris"
+			if err != nil {
+				candidate.Reason = err.Error()
+			}
+			continue
+		}
+		candidate.Key = this.PollarisKey(effective)
+		attrs := attributesOf(effective)
+		m, ok := matchAttributes(variant, attrs, device)
+		if !ok {
+			candidate.Reason = mismatchOf(attrs, device)
+			continue
+		}
+		if m.unverified > 0 {
+			candidate.Reason = unverifiedOf(attrs, device)
+			continue
+		}
+		candidate.Reason = "matched " + strconv.Itoa(m.matched) + " attributes, " + strconv.Itoa(m.literal) + " literally"
+		if best == nil || m.better(best) {
+			best = m
+		}
+	}
+	if best == nil {
+		return ""
+	}
+	if trace != nil {
+		for _, candidate := range candidates {
+			if candidate.Name == best.name {
+				candidate.Chosen = true
+			} else if candidate.Key != "" && strings.HasPrefix(candidate.Reason, "matched") {
+				candidate.Reason = candidate.Reason + ", outranked by " + best.name
+			}
+		}
+		trace.MatchedKey = candidates[best.name].Key
+	}
+	return best.name
+}
+
+// attributeNames are the names of the device attributes, in key order.
+var attributeNames = attributes{"vendor", "series", "family", "software", "hardware", "version"}
+
+// mismatchOf describes the first pollaris attribute contradicting the device.
+func mismatchOf(pollaris, device attributes) string {
+	for i := range pollaris {
+		if pollaris[i] == "" || device[i] == "" {
+			continue
+		}
+		ok, _ := matchField(i, pollaris[i], device[i])
+		if !ok {
+			return attributeNames[i] + " " + pollaris[i] + " does not match " + device[i]
+		}
+	}
+	return "does not match"
+}
+
+// unverifiedOf describes the pollaris attributes the device does not report.
+func unverifiedOf(pollaris, device attributes) string {
+	missing := make([]string, 0)
+	for i := range pollaris {
+		if pollaris[i] != "" && device[i] == "" {
+			missing = append(missing, attributeNames[i])
+		}
+	}
+	return "device does not report " + strings.Join(missing, ", ")
+}
+
+// variantsOf returns, sorted by name, the named pollaris and every pollaris
+// whose Extends chain includes it.
+func (this *PollarisCenter) variantsOf(name string) []string {
+	result := make([]string, 0)
+	for _, candidate := range this.AllNames() {
+		definition := this.Definition(candidate)
+		if definition == nil {
+			continue
+		}
+		if candidate == name {
+			result = append(result, candidate)
+			continue
+		}
+		if definition.Extends == "" {
+			continue
+		}
+		chain, err := this.chainOf(definition)
+		if err != nil {
+			continue
+		}
+		for _, base := range chain {
+			if base.Name == name {
+				result = append(result, candidate)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// selectMembers returns, sorted by name, the best matching member for each
+// logical pollaris among the given member names. Members are grouped into
+// logical pollarises by the root of their Extends chain.
+func (this *PollarisCenter) selectMembers(members []string, device attributes) []string {
+	best := make(map[string]*match)
+	for _, name := range members {
+		effective := this.PollarisByName(name)
+		if effective == nil {
+			continue
+		}
+		m, ok := matchAttributes(name, attributesOf(effective), device)
+		if !ok {
+			continue
+		}
+		root := this.rootOf(name)
+		current, exist := best[root]
+		if !exist || m.better(current) {
+			best[root] = m
+		}
+	}
+	result := make([]string, 0, len(best))
+	for _, m := range best {
+		result = append(result, m.name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// rootOf returns the name of the root of the Extends chain of the named
+// pollaris, or the name itself if the chain cannot be resolved.
+func (this *PollarisCenter) rootOf(name string) string {
+	definition := this.resolveDefinition(name)
+	if definition == nil {
+		return name
+	}
+	chain, err := this.chainOf(definition)
+	if err != nil {
+		return name
+	}
+	return chain[len(chain)-1].Name
+}
@@ -0,0 +1,157 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"google.golang.org/protobuf/proto"
+)
+
+// patchAttempts is the number of times Patch merges a patch again when the
+// pollaris is changed concurrently, before giving up.
+const patchAttempts = 5
+
+// errConcurrentChange is returned by a conditional put when the stored
+// definition was changed since it was read.
+var errConcurrentChange = errors.New("Pollaris was changed concurrently")
+
+// Patch merges a partial L8Pollaris into the existing pollaris with the same name.
+// Non-empty device attributes and Extends replace the existing ones, groups are
+// added and the names in RemoveGroups are removed. Each entry in Polling either
+// adds a new poll or is merged into the existing poll with the same name, and the
+// names in RemovePolls are removed. The merged result is stored using Put, so the
+// key and group indexes are rebuilt for the pollaris.
+// The merged result is only stored if the pollaris is still at the revision it
+// was merged into; otherwise the patch is merged again into the new revision,
+// so concurrent patches do not overwrite each other.
+// Returns an error if the pollaris does not exist, the merged result is invalid
+// or the pollaris keeps changing concurrently.
+func (this *PollarisCenter) Patch(patch *l8tpollaris.L8Pollaris, isNotification bool) error {
+	if patch.Name == "" {
+		return errors.New("Pollaris does not contain a Name")
+	}
+	for attempt := 0; attempt < patchAttempts; attempt++ {
+		existing := this.Definition(patch.Name)
+		if existing == nil {
+			return errors.New("Cannot find Pollaris " + patch.Name)
+		}
+		revision := existing.Revision
+		merged := mergePollaris(existing, patch)
+		if len(merged.Polling) == 0 && merged.Extends == "" {
+			return errors.New("Pollaris " + patch.Name + ": patch removes all polls")
+		}
+		err := this.put(merged, isNotification, revision)
+		if err != errConcurrentChange {
+			return err
+		}
+	}
+	return errors.New("Pollaris " + patch.Name + " was changed concurrently, patch not applied")
+}
+
+// mergePollaris returns a copy of existing with the patch applied.
+// The existing pollaris is not modified.
+func mergePollaris(existing, patch *l8tpollaris.L8Pollaris) *l8tpollaris.L8Pollaris {
+	merged := proto.Clone(existing).(*l8tpollaris.L8Pollaris)
+	mergeString(&merged.Vendor, patch.Vendor)
+	mergeString(&merged.Series, patch.Series)
+	mergeString(&merged.Family, patch.Family)
+	mergeString(&merged.Software, patch.Software)
+	mergeString(&merged.Hardware, patch.Hardware)
+	mergeString(&merged.Version, patch.Version)
+	mergeString(&merged.Extends, patch.Extends)
+
+	for _, gName := range patch.Groups {
+		if !contains(merged.Groups, gName) {
+			merged.Groups = append(merged.Groups, gName)
+		}
+	}
+	if len(patch.RemoveGroups) > 0 {
+		groups := make([]string, 0, len(merged.Groups))
+		for _, gName := range merged.Groups {
+			if !contains(patch.RemoveGroups, gName) {
+				groups = append(groups, gName)
+			}
+		}
+		merged.Groups = groups
+	}
+
+	if merged.Polling == nil {
+		merged.Polling = make(map[string]*l8tpollaris.L8Poll)
+	}
+	for pollName, poll := range patch.Polling {
+		existPoll, ok := merged.Polling[pollName]
+		if !ok {
+			merged.Polling[pollName] = proto.Clone(poll).(*l8tpollaris.L8Poll)
+			continue
+		}
+		mergePoll(existPoll, poll)
+	}
+	for _, pollName := range patch.RemovePolls {
+		delete(merged.Polling, pollName)
+	}
+
+	merged.RemovePolls = nil
+	merged.RemoveGroups = nil
+	return merged
+}
+
+// mergePoll applies the non-empty fields of patch onto poll.
+// A cadence or attribute list in the patch replaces the existing one as a whole.
+// Always can only be switched on by a patch, as false is indistinguishable
+// from an unset value.
+func mergePoll(poll, patch *l8tpollaris.L8Poll) {
+	mergeString(&poll.What, patch.What)
+	mergeString(&poll.BodyName, patch.BodyName)
+	mergeString(&poll.RespName, patch.RespName)
+	if patch.Operation != l8tpollaris.L8C_Operation_Invalid_Operation {
+		poll.Operation = patch.Operation
+	}
+	if patch.Protocol != l8tpollaris.L8PProtocol_L8PInvalid_Protocol {
+		poll.Protocol = patch.Protocol
+	}
+	if patch.Timeout != 0 {
+		poll.Timeout = patch.Timeout
+	}
+	if patch.Cadence != nil {
+		poll.Cadence = proto.Clone(patch.Cadence).(*l8tpollaris.L8PCadencePlan)
+	}
+	if len(patch.Attributes) > 0 {
+		poll.Attributes = make([]*l8tpollaris.L8PAttribute, 0, len(patch.Attributes))
+		for _, attr := range patch.Attributes {
+			poll.Attributes = append(poll.Attributes, proto.Clone(attr).(*l8tpollaris.L8PAttribute))
+		}
+	}
+	if patch.Always {
+		poll.Always = true
+	}
+}
+
+// mergeString replaces the target with value when value is not empty.
+func mergeString(target *string, value string) {
+	if value != "" {
+		*target = value
+	}
+}
+
+// contains reports whether the list contains the given string.
+func contains(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
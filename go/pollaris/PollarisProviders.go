@@ -0,0 +1,158 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/saichler/l8parser/go/parser/boot"
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// BootProviderName is the name of the provider of the l8parser boot models,
+// which is registered by default with priority 0.
+const BootProviderName = "boot"
+
+// ModelProvider is a source of pollaris models loaded by Activate, such as
+// compiled-in models, a directory of files or a remote service.
+type ModelProvider interface {
+	// Name identifies the provider in the registry and in logs
+	Name() string
+	// Priority decides which provider wins when several provide a pollaris
+	// with the same name; the highest priority wins
+	Priority() int
+	// Models returns the pollaris models of the provider
+	Models() ([]*l8tpollaris.L8Pollaris, error)
+}
+
+// funcProvider adapts a function to the ModelProvider interface.
+type funcProvider struct {
+	// name is the provider name
+	name string
+	// priority is the provider priority
+	priority int
+	// models returns the provider models
+	models func() ([]*l8tpollaris.L8Pollaris, error)
+}
+
+// Name returns the provider name.
+func (this *funcProvider) Name() string {
+	return this.name
+}
+
+// Priority returns the provider priority.
+func (this *funcProvider) Priority() int {
+	return this.priority
+}
+
+// Models calls the provider function.
+func (this *funcProvider) Models() ([]*l8tpollaris.L8Pollaris, error) {
+	return this.models()
+}
+
+// NewModelProvider creates a provider that returns the models of the function.
+func NewModelProvider(name string, priority int, models func() ([]*l8tpollaris.L8Pollaris, error)) ModelProvider {
+	return &funcProvider{name: name, priority: priority, models: models}
+}
+
+// NewDirectoryProvider creates a provider of the models in the JSON and YAML
+// files of a directory, see LoadModelsDirectory.
+func NewDirectoryProvider(name string, priority int, dir string) ModelProvider {
+	return NewModelProvider(name, priority, func() ([]*l8tpollaris.L8Pollaris, error) {
+		return LoadModelsDirectory(dir)
+	})
+}
+
+// bootModels returns the models of the l8parser boot package.
+func bootModels() ([]*l8tpollaris.L8Pollaris, error) {
+	result := make([]*l8tpollaris.L8Pollaris, 0)
+	result = append(result, boot.GetAllPolarisModels()...)
+	result = append(result, boot.CreateK8sBootPolls())
+	return result, nil
+}
+
+// providers holds the registered model providers by name.
+var providers = map[string]ModelProvider{
+	BootProviderName: NewModelProvider(BootProviderName, 0, bootModels),
+}
+
+// providersMtx protects the providers map.
+var providersMtx = &sync.RWMutex{}
+
+// RegisterProvider adds, or replaces, a named model provider. Providers
+// should be registered by the application before calling Activate. The
+// l8parser boot models are registered as BootProviderName and can be
+// replaced or unregistered.
+func RegisterProvider(provider ModelProvider) {
+	providersMtx.Lock()
+	defer providersMtx.Unlock()
+	providers[provider.Name()] = provider
+}
+
+// UnregisterProvider removes a named model provider.
+func UnregisterProvider(name string) {
+	providersMtx.Lock()
+	defer providersMtx.Unlock()
+	delete(providers, name)
+}
+
+// Providers returns the registered model providers ordered by ascending
+// priority, and by name for equal priorities.
+func Providers() []ModelProvider {
+	providersMtx.RLock()
+	result := make([]ModelProvider, 0, len(providers))
+	for _, provider := range providers {
+		result = append(result, provider)
+	}
+	providersMtx.RUnlock()
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Priority() != result[j].Priority() {
+			return result[i].Priority() < result[j].Priority()
+		}
+		return result[i].Name() < result[j].Name()
+	})
+	return result
+}
+
+// ProviderModels collects the models of all the registered providers. When
+// several providers return a pollaris with the same name, the one of the
+// provider with the highest priority is kept, with ties going to the provider
+// name that sorts last. A provider that fails is logged and skipped.
+func ProviderModels(log ifs.ILogger) []*l8tpollaris.L8Pollaris {
+	result := make([]*l8tpollaris.L8Pollaris, 0)
+	index := make(map[string]int)
+	for _, provider := range Providers() {
+		models, err := provider.Models()
+		if err != nil {
+			log.Error("Model provider ", provider.Name(), " failed: ", err.Error())
+			continue
+		}
+		for _, model := range models {
+			if model == nil {
+				continue
+			}
+			i, ok := index[model.Name]
+			if ok {
+				log.Info("Pollaris ", model.Name, " is overridden by model provider ", provider.Name())
+				result[i] = model
+				continue
+			}
+			index[model.Name] = len(result)
+			result = append(result, model)
+		}
+	}
+	return result
+}
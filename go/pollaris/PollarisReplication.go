@@ -0,0 +1,187 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/proto"
+)
+
+// ConflictResolution decides which of two changes to the same pollaris, with
+// the same revision, wins when they are made concurrently on different instances.
+type ConflictResolution int
+
+const (
+	// LastWriterWins keeps the change with the latest modification time,
+	// breaking ties by the origin instance uuid.
+	LastWriterWins ConflictResolution = iota
+	// LeaderArbitrated keeps the change made by the service leader, and falls
+	// back to LastWriterWins when neither change was made by the leader.
+	LeaderArbitrated
+)
+
+// Synced enables the synchronized mode, in which the pollaris cache is
+// replicated to all the Pollaris service instances, changes received from
+// peers are ordered by revision and a newly activated instance pulls a full
+// snapshot from the service leader. This should be set before Activate.
+var Synced bool
+
+// Conflicts is the conflict resolution used in the synchronized mode.
+var Conflicts = LastWriterWins
+
+// SnapshotTimeout is the number of seconds to wait for the leader snapshot.
+var SnapshotTimeout = 30
+
+// SnapshotRetryInterval is how long a newly activated instance waits before
+// pulling the leader snapshot again after a failed pull. The wait doubles
+// after each failure, up to SnapshotMaxRetryInterval.
+var SnapshotRetryInterval = time.Second
+
+// SnapshotMaxRetryInterval is the longest wait between two snapshot pulls.
+var SnapshotMaxRetryInterval = time.Minute
+
+// errNoLeader is returned by PullSnapshot when no service leader is known yet.
+var errNoLeader = errors.New("No Pollaris service leader is known")
+
+// stampLocked sets the modification time and origin of a local change.
+// Changes received from peers keep the stamp of the originating instance.
+// Caller must hold this.mtx write lock.
+func (this *PollarisCenter) stampLocked(l8pollaris *l8tpollaris.L8Pollaris, isNotification bool) {
+	if isNotification && l8pollaris.Modified != 0 {
+		return
+	}
+	l8pollaris.Modified = time.Now().UnixNano()
+	l8pollaris.Origin = this.localUuid()
+}
+
+// staleLocked reports whether a change received from a peer is older than, or
+// loses the conflict with, the stored definition, and should be ignored.
+// Local changes and changes without a revision are never stale.
+// Caller must hold this.mtx write lock.
+func (this *PollarisCenter) staleLocked(l8pollaris *l8tpollaris.L8Pollaris, isNotification bool) bool {
+	if !isNotification || l8pollaris.Revision == 0 {
+		return false
+	}
+	existing := this.Definition(l8pollaris.Name)
+	if existing == nil {
+		return false
+	}
+	if this.newer(l8pollaris, existing) {
+		return false
+	}
+	this.log.Info("Ignoring stale Pollaris ", l8pollaris.Name, " revision ", l8pollaris.Revision,
+		" from ", l8pollaris.Origin)
+	return true
+}
+
+// newer reports whether a is a later change than b. The higher revision wins;
+// for the same revision, the change is picked by the conflict resolution.
+func (this *PollarisCenter) newer(a, b *l8tpollaris.L8Pollaris) bool {
+	if a.Revision != b.Revision {
+		return a.Revision > b.Revision
+	}
+	return this.newerStamp(a.Modified, a.Origin, b.Modified, b.Origin)
+}
+
+// newerStamp reports whether the change stamped with aModified and aOrigin
+// wins the conflict with the one stamped with bModified and bOrigin.
+func (this *PollarisCenter) newerStamp(aModified int64, aOrigin string, bModified int64, bOrigin string) bool {
+	if this.conflicts == LeaderArbitrated {
+		leader := this.leader()
+		if aOrigin == leader && bOrigin != leader {
+			return true
+		}
+		if bOrigin == leader && aOrigin != leader {
+			return false
+		}
+	}
+	if aModified != bModified {
+		return aModified > bModified
+	}
+	return aOrigin > bOrigin
+}
+
+// leader returns the uuid of the Pollaris service leader.
+func (this *PollarisCenter) leader() string {
+	return this.resources.Services().GetLeader(ServiceName, this.area)
+}
+
+// localUuid returns the uuid of this instance.
+func (this *PollarisCenter) localUuid() string {
+	return this.resources.SysConfig().LocalUuid
+}
+
+// Snapshot returns the definitions of all the pollarises in the center.
+// The group definitions are returned by GroupSnapshot.
+func (this *PollarisCenter) Snapshot() []*l8tpollaris.L8Pollaris {
+	result := make([]*l8tpollaris.L8Pollaris, 0)
+	for _, name := range this.AllNames() {
+		l8pollaris := this.Definition(name)
+		if l8pollaris != nil {
+			result = append(result, l8pollaris)
+		}
+	}
+	return result
+}
+
+// Merge applies the definitions of a peer snapshot as notifications, so each
+// of them replaces the local definition only if it is newer.
+// Returns the first error encountered, after merging all the definitions.
+func (this *PollarisCenter) Merge(list []*l8tpollaris.L8Pollaris) error {
+	var result error
+	for _, l8pollaris := range list {
+		err := this.Put(proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris), true)
+		if err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
+
+// PullSnapshot requests the full snapshot of the service leader and merges
+// it into the center, the pollarises first and then the group definitions.
+// It does nothing on the leader itself, and returns an error when no leader
+// is known yet, so the caller can try again later.
+func (this *PollarisCenter) PullSnapshot(vnic ifs.IVNic) error {
+	leader := this.leader()
+	if leader == "" {
+		return errNoLeader
+	}
+	if leader == this.localUuid() {
+		return nil
+	}
+	resp := vnic.Request(leader, ServiceName, this.area, ifs.POST, &l8tpollaris.L8PollarisSnapshot{}, SnapshotTimeout)
+	if resp == nil {
+		return errors.New("No snapshot received from leader " + leader)
+	}
+	if resp.Error() != nil {
+		return resp.Error()
+	}
+	snapshot, ok := resp.Element().(*l8tpollaris.L8PollarisSnapshot)
+	if !ok {
+		return errors.New("Unexpected snapshot response from leader " + leader)
+	}
+	this.log.Info("Merging snapshot of ", len(snapshot.List), " pollarises and ", len(snapshot.Groups),
+		" group definitions from leader ", leader)
+	err := this.Merge(snapshot.List)
+	groupErr := this.MergeGroups(snapshot.Groups)
+	if err != nil {
+		return err
+	}
+	return groupErr
+}
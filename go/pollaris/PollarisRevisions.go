@@ -0,0 +1,102 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultMaxRevisions is the number of revisions retained per pollaris
+// unless changed with SetMaxRevisions.
+const DefaultMaxRevisions = 10
+
+// SetMaxRevisions sets the number of revisions retained per pollaris.
+// Existing histories are trimmed on their next update.
+func (this *PollarisCenter) SetMaxRevisions(max int) {
+	if max < 1 {
+		max = 1
+	}
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.maxRevisions = max
+}
+
+// addRevisionLocked assigns the next revision to the pollaris and appends a
+// copy of it to the pollaris history, trimming the history to maxRevisions.
+// Notifications keep the revision assigned by the originating instance, and
+// replace the last entry when it has the same revision.
+// Caller must hold this.mtx write lock.
+func (this *PollarisCenter) addRevisionLocked(l8pollaris *l8tpollaris.L8Pollaris, isNotification bool) {
+	history := this.revisions[l8pollaris.Name]
+	if !isNotification || l8pollaris.Revision == 0 {
+		l8pollaris.Revision = 1
+		if len(history) > 0 {
+			l8pollaris.Revision = history[len(history)-1].Revision + 1
+		}
+	}
+	entry := proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+	if len(history) > 0 && history[len(history)-1].Revision == entry.Revision {
+		// a concurrent change with the same revision won the conflict
+		history[len(history)-1] = entry
+	} else {
+		history = append(history, entry)
+	}
+	if len(history) > this.maxRevisions {
+		history = history[len(history)-this.maxRevisions:]
+	}
+	this.revisions[l8pollaris.Name] = history
+}
+
+// Revisions returns copies of the retained revisions of the named pollaris,
+// oldest first. The history is kept after a pollaris is deleted so that a
+// deletion can be rolled back as well.
+func (this *PollarisCenter) Revisions(name string) []*l8tpollaris.L8Pollaris {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	history := this.revisions[name]
+	result := make([]*l8tpollaris.L8Pollaris, 0, len(history))
+	for _, l8pollaris := range history {
+		result = append(result, proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris))
+	}
+	return result
+}
+
+// Revision returns a copy of a specific revision of the named pollaris,
+// or nil if that revision is not retained.
+func (this *PollarisCenter) Revision(name string, revision int64) *l8tpollaris.L8Pollaris {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	for _, l8pollaris := range this.revisions[name] {
+		if l8pollaris.Revision == revision {
+			return proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+		}
+	}
+	return nil
+}
+
+// Rollback restores the named pollaris to the content of the given revision.
+// The restored content is stored with Put under a new revision, so the
+// history stays monotonic and the rollback itself can be reverted.
+// Returns an error if the revision is not retained.
+func (this *PollarisCenter) Rollback(name string, revision int64, isNotification bool) error {
+	l8pollaris := this.Revision(name, revision)
+	if l8pollaris == nil {
+		return errors.New("Cannot find revision " + strconv.FormatInt(revision, 10) + " of Pollaris " + name)
+	}
+	return this.Put(l8pollaris, isNotification)
+}
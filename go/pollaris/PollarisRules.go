@@ -0,0 +1,76 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+
+	"github.com/saichler/l8parser/go/parser/rules"
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// parserRules lists the rule implementations of the l8parser.
+var parserRules = []rules.ParsingRule{
+	&rules.Set{},
+	&rules.Contains{},
+	&rules.ToTable{},
+	&rules.TableToMap{},
+	&rules.StringToCTable{},
+	&rules.CTableToMapProperty{},
+}
+
+// ParserRules adapts the rule implementations of the l8parser, by rule name.
+// It is the default rule registry of the validation and the default rule
+// engine of the dry runs.
+type ParserRules struct {
+	resources ifs.IResources
+	rules     map[string]rules.ParsingRule
+}
+
+// NewParserRules creates the adapter of the l8parser rules, running them with
+// the given resources.
+func NewParserRules(resources ifs.IResources) *ParserRules {
+	parser := &ParserRules{resources: resources, rules: make(map[string]rules.ParsingRule)}
+	for _, rule := range parserRules {
+		parser.rules[rule.Name()] = rule
+	}
+	return parser
+}
+
+// Exists returns true if the l8parser implements the named rule.
+func (this *ParserRules) Exists(name string) bool {
+	_, ok := this.rules[name]
+	return ok
+}
+
+// Apply runs the named l8parser rule with the input in its workspace and
+// returns the output it leaves there, or the input as is for a rule that
+// leaves no output. Returns an error for an unknown rule or a failing one.
+func (this *ParserRules) Apply(name string, params map[string]*l8tpollaris.L8PParameter, input interface{}) (interface{}, error) {
+	rule, ok := this.rules[name]
+	if !ok {
+		return nil, errors.New("unknown rule")
+	}
+	workSpace := map[string]interface{}{rules.Input: input}
+	err := rule.Parse(this.resources, workSpace, params, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	output, ok := workSpace[rules.Output]
+	if !ok {
+		return input, nil
+	}
+	return output, nil
+}
@@ -15,20 +15,25 @@ package pollaris
 
 import (
 	"errors"
-	"github.com/saichler/l8parser/go/parser/boot"
+	"sync"
+	"time"
 
+	"github.com/saichler/l8pollaris/go/pollaris/audit"
 	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
 	"github.com/saichler/l8srlz/go/serialize/object"
 	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8types/go/types/l8api"
 	"github.com/saichler/l8types/go/types/l8web"
 	"github.com/saichler/l8utils/go/utils/web"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
 	// ServiceName is the registered name of the Pollaris service in the service registry.
 	ServiceName = "Pollaris"
 	// ServiceArea defines the service area (partition) for the Pollaris service.
-	// Area 0 indicates the default/global service area.
+	// Area 0 indicates the default/global service area, which the tenant
+	// areas activated with ActivateArea fall back to.
 	ServiceArea = 0
 )
 
@@ -40,52 +45,162 @@ type PollarisService struct {
 	pollarisCenter *PollarisCenter
 	// serviceArea stores the service area for this instance
 	serviceArea byte
+	// watcher reloads the models in ModelsDirectory, when it is set
+	watcher *modelsWatcher
+	// done is closed when the service is deactivated
+	done chan bool
+	// once guards closing done
+	once *sync.Once
 }
 
 // Activate initializes and registers the Pollaris service with the VNic.
-// It loads all predefined polling models from the boot package, creates a
-// service level agreement, and activates the service in the service registry.
+// It loads the polling models of all the registered model providers, see
+// RegisterProvider, creates a service level agreement, and activates the
+// service in the service registry.
 // This is the main entry point for starting the Pollaris service.
 func Activate(vnic ifs.IVNic) error {
 	initData := []interface{}{}
-	for _, p := range boot.GetAllPolarisModels() {
+	for _, p := range ProviderModels(vnic.Resources().Logger()) {
 		initData = append(initData, p)
 	}
-	initData = append(initData, boot.CreateK8sBootPolls())
-	sla := ifs.NewServiceLevelAgreement(&PollarisService{}, ServiceName, ServiceArea, true, nil)
+	return activate(ServiceArea, initData, vnic)
+}
+
+// ActivateArea activates a Pollaris service for a tenant in its own service
+// area. The tenant area starts without the provider models and has its own
+// namespace, so it can define a pollaris with the same name as one in the
+// global ServiceArea, while lookups of pollarises it does not define fall
+// back to the global ServiceArea, see PollarisCenter.PollarisByKey.
+func ActivateArea(area byte, vnic ifs.IVNic) error {
+	return activate(area, nil, vnic)
+}
+
+// activate creates the service level agreement of an area and activates the
+// service in the service registry.
+func activate(area byte, initData []interface{}, vnic ifs.IVNic) error {
+	sla := ifs.NewServiceLevelAgreement(&PollarisService{}, ServiceName, area, true, nil)
 	sla.SetServiceItem(&l8tpollaris.L8Pollaris{})
-	sla.SetInitItems(initData)
-	vnic.Resources().Services().Activate(sla, vnic)
-	return nil
+	sla.SetServiceItemList(&l8tpollaris.L8PollarisList{})
+	if initData != nil {
+		sla.SetInitItems(initData)
+	}
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	return err
 }
 
 // Activate is called by the service framework to initialize this service instance.
 // It registers the L8Pollaris type with the registry and creates the PollarisCenter.
+// When ModelsDirectory is set, the models in it are loaded into the global
+// ServiceArea and the directory is watched.
+// In the Synced mode, the snapshot of the service leader is pulled in the
+// background, retrying until a leader is known and the pull succeeds.
+// The queries of the audit trail are authorized by PollarisAuthorizer, with
+// the sender of their message, see Dispatch.
 func (this *PollarisService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
 	vnic.Resources().Registry().Register(&l8tpollaris.L8Pollaris{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisList{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisRevisions{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisRollback{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisExplain{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisExport{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisImport{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisSnapshot{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisSubscription{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisEvent{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisDiff{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisLookup{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisGroup{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisGroupDefinition{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisGroupTree{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisGroups{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisIndex{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisKeys{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisPoll{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PollarisDryRun{})
 	this.pollarisCenter = newPollarisCenter(sla, vnic)
 	this.serviceArea = sla.ServiceArea()
+	this.done = make(chan bool)
+	this.once = &sync.Once{}
+	audit.Authorize = authorizeAudit
+	audit.Dispatch = Dispatch
+	if ModelsDirectory != "" && this.serviceArea == ServiceArea {
+		this.watcher = newModelsWatcher(ModelsDirectory, this.pollarisCenter, vnic.Resources())
+		this.watcher.scan()
+		go this.watcher.watch()
+	}
+	if Synced {
+		go this.pullSnapshot(this.pollarisCenter, vnic)
+	}
 	return nil
 }
 
 // DeActivate is called when the service is being shut down.
-// It cleans up resources by setting the pollarisCenter to nil.
+// It stops watching the models directory and pulling the leader snapshot,
+// and cleans up resources by setting the pollarisCenter to nil.
 func (this *PollarisService) DeActivate() error {
+	if this.once != nil {
+		this.once.Do(func() {
+			close(this.done)
+		})
+	}
+	if this.watcher != nil {
+		this.watcher.stop()
+		this.watcher = nil
+	}
 	this.pollarisCenter = nil
 	return nil
 }
 
+// pullSnapshot merges the snapshot of the service leader into the center.
+// A failed pull, e.g. when no leader is elected yet, is retried after
+// SnapshotRetryInterval, doubling the wait after each failure up to
+// SnapshotMaxRetryInterval, until the pull succeeds or the service is
+// deactivated.
+func (this *PollarisService) pullSnapshot(center *PollarisCenter, vnic ifs.IVNic) {
+	wait := SnapshotRetryInterval
+	for {
+		err := center.PullSnapshot(vnic)
+		if err == nil {
+			return
+		}
+		vnic.Resources().Logger().Warning("Cannot pull pollaris snapshot, retrying in ", wait.String(), ": ", err.Error())
+		select {
+		case <-this.done:
+			return
+		case <-time.After(wait):
+		}
+		wait *= 2
+		if wait > SnapshotMaxRetryInterval {
+			wait = SnapshotMaxRetryInterval
+		}
+	}
+}
+
 // Post handles creation of new L8Pollaris configurations.
 // It iterates through the elements, validates each as L8Pollaris,
 // and adds them to the PollarisCenter. Returns an empty response with
-// any error that occurred during processing.
+// any error that occurred during processing. Action messages, such as
+// L8PollarisRollback, are dispatched to doAction instead.
+// When a PollarisAuthorizer is set, the elements the caller is not permitted
+// to store are skipped and the denial is returned as the error.
 func (this *PollarisService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	resp, ok := this.doAction(pb, vnic)
+	if ok {
+		return resp
+	}
 	var err error
 	for _, elem := range pb.Elements() {
 		l8Pollaris, ok := elem.(*l8tpollaris.L8Pollaris)
 		if ok {
+			e := this.authorizeWrite(pb, l8Pollaris)
+			if e != nil {
+				err = e
+				continue
+			}
 			vnic.Resources().Logger().Info("Added a l8Pollaris ", l8Pollaris.Name)
-			e := this.pollarisCenter.Post(l8Pollaris, pb.Notification())
+			e = this.audited(pb, "POST", l8Pollaris.Name, func() error {
+				return this.pollarisCenter.Post(l8Pollaris, pb.Notification())
+			})
 			if e != nil {
 				err = e
 			}
@@ -95,6 +210,7 @@ func (this *PollarisService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElement
 	}
 	return object.New(err, &l8web.L8Empty{})
 }
+
 // Put handles updates to existing L8Pollaris configurations.
 // Similar to Post, but uses the Put method on PollarisCenter which
 // is semantically an update operation.
@@ -103,8 +219,15 @@ func (this *PollarisService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements
 	for _, elem := range pb.Elements() {
 		l8Pollaris, ok := elem.(*l8tpollaris.L8Pollaris)
 		if ok {
+			e := this.authorizeWrite(pb, l8Pollaris)
+			if e != nil {
+				err = e
+				continue
+			}
 			vnic.Resources().Logger().Info("Added a l8Pollaris ", l8Pollaris.Name)
-			e := this.pollarisCenter.Put(l8Pollaris, pb.Notification())
+			e = this.audited(pb, "PUT", l8Pollaris.Name, func() error {
+				return this.pollarisCenter.Put(l8Pollaris, pb.Notification())
+			})
 			if e != nil {
 				err = e
 			}
@@ -114,28 +237,170 @@ func (this *PollarisService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements
 	}
 	return object.New(err, &l8web.L8Empty{})
 }
+
 // Patch handles partial updates to L8Pollaris configurations.
-// Currently not implemented - returns nil.
+// Each element is a partial L8Pollaris identified by name that is merged into
+// the existing pollaris, see PollarisCenter.Patch for the merge semantics.
+// The caller must be permitted to update both the existing and the patched pollaris.
 func (this *PollarisService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
-	return nil
+	var err error
+	for _, elem := range pb.Elements() {
+		l8Pollaris, ok := elem.(*l8tpollaris.L8Pollaris)
+		if ok {
+			existing := this.pollarisCenter.Definition(l8Pollaris.Name)
+			if existing != nil {
+				e := this.authorizeWrite(pb, mergePollaris(existing, l8Pollaris))
+				if e != nil {
+					err = e
+					continue
+				}
+			}
+			vnic.Resources().Logger().Info("Patched a l8Pollaris ", l8Pollaris.Name)
+			e := this.audited(pb, "PATCH", l8Pollaris.Name, func() error {
+				return this.pollarisCenter.Patch(l8Pollaris, pb.Notification())
+			})
+			if e != nil {
+				err = e
+			}
+		} else {
+			err = errors.New("Element is not a L8Pollaris")
+		}
+	}
+	return object.New(err, &l8web.L8Empty{})
 }
 
 // Delete handles deletion of L8Pollaris configurations.
-// Currently not implemented - returns nil.
+// A L8Pollaris element carrying only a name deletes by name, one carrying
+// device attributes (vendor, series, ...) deletes by its exact composite key.
+// Any other request is parsed as an L8Query and every matching pollaris is
+// deleted. Returns an empty response with any error that occurred.
+// A query deletes nothing unless the caller is permitted to delete every
+// matching pollaris.
 func (this *PollarisService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
-	return nil
+	_, ok := pb.Element().(*l8tpollaris.L8Pollaris)
+	if !ok {
+		query, err := pb.Query(vnic.Resources())
+		if err != nil {
+			return object.New(err, &l8web.L8Empty{})
+		}
+		before := make(map[string]*l8tpollaris.L8Pollaris)
+		for _, l8pollaris := range this.pollarisCenter.Query(query) {
+			err = this.authorize(pb, PermissionDelete, l8pollaris)
+			if err != nil {
+				return object.New(err, &l8web.L8Empty{})
+			}
+			before[l8pollaris.Name] = proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+		}
+		deleted, err := this.pollarisCenter.DeleteByQuery(query, pb.Notification())
+		vnic.Resources().Logger().Info("Deleted l8Pollaris ", deleted)
+		if audit.Enabled() && !this.fromPeer(pb) {
+			for _, name := range deleted {
+				this.record(pb, "DELETE", name, before[name])
+			}
+		}
+		return object.New(err, &l8web.L8Empty{})
+	}
+
+	var err error
+	for _, elem := range pb.Elements() {
+		l8Pollaris, ok := elem.(*l8tpollaris.L8Pollaris)
+		if !ok {
+			err = errors.New("Element is not a L8Pollaris")
+			continue
+		}
+		name := l8Pollaris.Name
+		if hasDeviceAttributes(l8Pollaris) {
+			keyName, found := this.pollarisCenter.getPollName(this.pollarisCenter.PollarisKey(l8Pollaris))
+			if found {
+				name = keyName
+			}
+		}
+		e := this.authorizeName(pb, PermissionDelete, name)
+		if e != nil {
+			err = e
+			continue
+		}
+		e = this.audited(pb, "DELETE", name, func() error {
+			if hasDeviceAttributes(l8Pollaris) {
+				vnic.Resources().Logger().Info("Deleting l8Pollaris by key ", this.pollarisCenter.PollarisKey(l8Pollaris))
+				return this.pollarisCenter.DeleteByKey(pb.Notification(), l8Pollaris.Name, l8Pollaris.Vendor,
+					l8Pollaris.Series, l8Pollaris.Family, l8Pollaris.Software, l8Pollaris.Hardware, l8Pollaris.Version)
+			}
+			vnic.Resources().Logger().Info("Deleting l8Pollaris ", l8Pollaris.Name)
+			return this.pollarisCenter.Delete(l8Pollaris, pb.Notification())
+		})
+		if e != nil {
+			err = e
+		}
+	}
+	return object.New(err, &l8web.L8Empty{})
 }
 
 // Get handles retrieval of L8Pollaris configurations.
-// Currently not implemented - returns nil. Use PollarisByName or PollarisByKey instead.
+// When the request element is a L8Pollaris, it is treated as a filter and the
+// pollaris with the same name is returned. A key lookup, a group resolution,
+// a single poll, the group catalog, the reverse index or the key table
+// request is resolved, see doRead. Otherwise the request is parsed as
+// an L8Query (e.g. "select * from L8Pollaris where vendor=cisco") and the
+// matching pollarises are returned in a L8PollarisList, paged by the query
+// limit and page, with the number of matches before paging in the "Total"
+// key count of the list metadata. When a PollarisAuthorizer is set, reading a pollaris the
+// caller is not permitted to read is an error, and a query only returns the
+// pollarises the caller is permitted to read.
 func (this *PollarisService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
-	return nil
+	return this.get(pb, vnic, false)
 }
 
 // GetCopy returns a copy of the requested L8Pollaris configurations.
-// Currently not implemented - returns nil.
+// It behaves like Get, but the returned objects are cloned so the caller
+// can modify them without affecting the cached definitions.
 func (this *PollarisService) GetCopy(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
-	return nil
+	return this.get(pb, vnic, true)
+}
+
+// get implements Get and GetCopy, optionally cloning the returned pollarises.
+func (this *PollarisService) get(pb ifs.IElements, vnic ifs.IVNic, clone bool) ifs.IElements {
+	resp, ok := this.doRead(pb)
+	if ok {
+		return resp
+	}
+	filter, ok := pb.Element().(*l8tpollaris.L8Pollaris)
+	if ok {
+		l8pollaris := this.pollarisCenter.Definition(filter.Name)
+		if l8pollaris == nil {
+			return object.New(errors.New("Cannot find Pollaris "+filter.Name), &l8tpollaris.L8Pollaris{})
+		}
+		err := this.authorize(pb, PermissionRead, l8pollaris)
+		if err != nil {
+			return object.New(err, &l8tpollaris.L8Pollaris{})
+		}
+		if clone {
+			l8pollaris = proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+		}
+		return object.New(nil, l8pollaris)
+	}
+
+	query, err := pb.Query(vnic.Resources())
+	if err != nil {
+		return object.New(err, &l8tpollaris.L8PollarisList{})
+	}
+	matched := this.readable(pb, this.pollarisCenter.Query(query))
+	list := pageOf(matched, query.Limit(), query.Page())
+	result := &l8tpollaris.L8PollarisList{List: make([]*l8tpollaris.L8Pollaris, 0, len(list)),
+		Metadata: &l8api.L8MetaData{KeyCount: map[string]int32{"Total": int32(len(matched))}}}
+	for _, l8pollaris := range list {
+		if clone {
+			l8pollaris = proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+		}
+		result.List = append(result.List, l8pollaris)
+	}
+	return object.New(nil, result)
+}
+
+// Handle is called by the service framework with the incoming message, so
+// the sender of the message is authorized and audited, see Dispatch.
+func (this *PollarisService) Handle(pb ifs.IElements, msg *ifs.Message, vnic ifs.IVNic) ifs.IElements {
+	return Dispatch(this, pb, msg, vnic)
 }
 
 // Failed handles failed message delivery for L8Pollaris operations.
@@ -149,12 +414,48 @@ func (this *PollarisService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.M
 func (this *PollarisService) TransactionConfig() ifs.ITransactionConfig {
 	return nil
 }
-// WebService returns the web service configuration for the Pollaris service.
-// It exposes POST and PUT endpoints for L8Pollaris objects, allowing
-// external clients to create and update polling configurations via HTTP.
+
+// WebService returns the web service configuration for the Pollaris service,
+// giving non-Go clients parity with the in-process API:
+//   - POST, PUT and PATCH of L8Pollaris to create and update configurations
+//   - GET with an L8Query, returning a L8PollarisList
+//   - GET of a key lookup, a group resolution, a single poll, the group
+//     catalog, the reverse index or the key table
+//   - DELETE with either a L8Pollaris or an L8Query
+//   - POST of action messages to list revisions and roll back, explain and
+//     run a key lookup, resolve a group, fetch a single poll, export and
+//     import bundles, pull a snapshot, subscribe to change events, diff
+//     definitions or revisions and dry-run a pollaris on recorded responses
 func (this *PollarisService) WebService() ifs.IWebService {
-	ws := web.New(ServiceName, ServiceArea, 0)
+	ws := web.New(ServiceName, this.serviceArea, 0)
 	ws.AddEndpoint(&l8tpollaris.L8Pollaris{}, ifs.POST, &l8web.L8Empty{})
 	ws.AddEndpoint(&l8tpollaris.L8Pollaris{}, ifs.PUT, &l8web.L8Empty{})
+	ws.AddEndpoint(&l8tpollaris.L8Pollaris{}, ifs.PATCH, &l8web.L8Empty{})
+	ws.AddEndpoint(&l8api.L8Query{}, ifs.GET, &l8tpollaris.L8PollarisList{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisLookup{}, ifs.GET, &l8tpollaris.L8PollarisLookup{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisGroup{}, ifs.GET, &l8tpollaris.L8PollarisGroup{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisPoll{}, ifs.GET, &l8tpollaris.L8PollarisPoll{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisGroups{}, ifs.GET, &l8tpollaris.L8PollarisGroups{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisIndex{}, ifs.GET, &l8tpollaris.L8PollarisIndex{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisKeys{}, ifs.GET, &l8tpollaris.L8PollarisKeys{})
+	ws.AddEndpoint(&l8tpollaris.L8Pollaris{}, ifs.DELETE, &l8web.L8Empty{})
+	ws.AddEndpoint(&l8api.L8Query{}, ifs.DELETE, &l8web.L8Empty{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisRevisions{}, ifs.POST, &l8tpollaris.L8PollarisRevisions{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisRollback{}, ifs.POST, &l8web.L8Empty{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisExplain{}, ifs.POST, &l8tpollaris.L8PollarisExplain{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisExport{}, ifs.POST, &l8tpollaris.L8PollarisExport{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisImport{}, ifs.POST, &l8tpollaris.L8PollarisImport{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisSnapshot{}, ifs.POST, &l8tpollaris.L8PollarisSnapshot{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisSubscription{}, ifs.POST, &l8web.L8Empty{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisDiff{}, ifs.POST, &l8tpollaris.L8PollarisDiff{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisLookup{}, ifs.POST, &l8tpollaris.L8PollarisLookup{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisGroup{}, ifs.POST, &l8tpollaris.L8PollarisGroup{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisGroupDefinition{}, ifs.POST, &l8web.L8Empty{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisGroupTree{}, ifs.POST, &l8tpollaris.L8PollarisGroupTree{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisGroups{}, ifs.POST, &l8tpollaris.L8PollarisGroups{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisIndex{}, ifs.POST, &l8tpollaris.L8PollarisIndex{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisKeys{}, ifs.POST, &l8tpollaris.L8PollarisKeys{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisPoll{}, ifs.POST, &l8tpollaris.L8PollarisPoll{})
+	ws.AddEndpoint(&l8tpollaris.L8PollarisDryRun{}, ifs.POST, &l8tpollaris.L8PollarisDryRun{})
 	return ws
 }
@@ -0,0 +1,389 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8orm/go/orm/common"
+	"github.com/saichler/l8orm/go/orm/plugins/postgres"
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8ql/go/gsql/interpreter"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Store persists pollaris definitions so that models created, edited and
+// deleted at runtime survive a restart. A deleted pollaris is saved as a
+// tombstone, a definition with only the name and Deleted set, so that a
+// provider model with the same name is not restored on restart.
+type Store interface {
+	// Load returns all the persisted pollaris definitions and tombstones
+	Load() ([]*l8tpollaris.L8Pollaris, error)
+	// Save inserts or replaces the persisted definition or tombstone of the pollaris
+	Save(l8pollaris *l8tpollaris.L8Pollaris) error
+}
+
+// PollarisStore is the optional persistence backend of the PollarisCenter.
+// When set before Activate, the persisted definitions are loaded on startup,
+// replacing the provider models with the same name and dropping the ones
+// that were deleted, and every change to the center, including the ones
+// received from peers, is saved to it.
+// Tenant areas are persisted only when the store is an AreaStore, and the
+// group definitions only when it is a GroupStore.
+var PollarisStore Store
+
+// GroupStore is implemented by the stores that can persist the group
+// definitions too, see PollarisCenter.DefineGroup. A group definition
+// without includes is saved as is, as it removes the nesting of the group.
+type GroupStore interface {
+	// LoadGroups returns all the persisted group definitions
+	LoadGroups() ([]*l8tpollaris.L8PollarisGroupDefinition, error)
+	// SaveGroup inserts or replaces the persisted definition of the group
+	SaveGroup(definition *l8tpollaris.L8PollarisGroupDefinition) error
+}
+
+// AreaStore is implemented by the stores that can keep the pollarises of
+// each service area apart, so they can persist the tenant areas too.
+type AreaStore interface {
+	// ForArea returns the store of the service area
+	ForArea(area byte) Store
+}
+
+// storeOf returns the store of a service area: the PollarisStore for the
+// global ServiceArea, and its area store for a tenant area when it is an
+// AreaStore. Tenant areas are not persisted by other stores.
+func storeOf(area byte, log ifs.ILogger) Store {
+	if PollarisStore == nil || area == ServiceArea {
+		return PollarisStore
+	}
+	areaStore, ok := PollarisStore.(AreaStore)
+	if !ok {
+		log.Warning("PollarisStore does not support service areas, area ", area, " is not persisted")
+		return nil
+	}
+	return areaStore.ForArea(area)
+}
+
+// loadStore merges the persisted definitions into the init items, replacing
+// the items with the same name, and drops the items that have a persisted
+// tombstone. Returns the init items as is if there is no store or it fails
+// to load.
+func loadStore(store Store, initItems []interface{}, log ifs.ILogger) []interface{} {
+	if store == nil {
+		return initItems
+	}
+	persisted, err := store.Load()
+	if err != nil {
+		log.Error("Cannot load persisted pollarises: ", err.Error())
+		return initItems
+	}
+	log.Info("Loaded persisted pollarises ", len(persisted))
+	deleted := make(map[string]bool)
+	for _, l8pollaris := range persisted {
+		if l8pollaris.Deleted {
+			deleted[l8pollaris.Name] = true
+		}
+	}
+	index := make(map[string]int)
+	result := make([]interface{}, 0, len(initItems)+len(persisted))
+	for _, item := range initItems {
+		name := item.(*l8tpollaris.L8Pollaris).Name
+		if deleted[name] {
+			log.Info("Not restoring deleted Pollaris ", name)
+			continue
+		}
+		index[name] = len(result)
+		result = append(result, item)
+	}
+	for _, l8pollaris := range persisted {
+		if l8pollaris.Deleted {
+			continue
+		}
+		i, ok := index[l8pollaris.Name]
+		if ok {
+			result[i] = l8pollaris
+			continue
+		}
+		index[l8pollaris.Name] = len(result)
+		result = append(result, l8pollaris)
+	}
+	return result
+}
+
+// persist saves the pollaris to the store, if there is one.
+func (this *PollarisCenter) persist(l8pollaris *l8tpollaris.L8Pollaris) error {
+	if this.store == nil {
+		return nil
+	}
+	err := this.store.Save(l8pollaris)
+	if err != nil {
+		this.log.Error("Cannot persist Pollaris ", l8pollaris.Name, ": ", err.Error())
+		return errors.New("Pollaris " + l8pollaris.Name + " was stored but not persisted: " + err.Error())
+	}
+	return nil
+}
+
+// unpersist saves the tombstone of the named pollaris to the store, if
+// there is one.
+func (this *PollarisCenter) unpersist(name string) error {
+	if this.store == nil {
+		return nil
+	}
+	err := this.store.Save(&l8tpollaris.L8Pollaris{Name: name, Deleted: true,
+		Modified: time.Now().UnixNano(), Origin: this.localUuid()})
+	if err != nil {
+		this.log.Error("Cannot delete persisted Pollaris ", name, ": ", err.Error())
+		return errors.New("Pollaris " + name + " was deleted but not from persistence: " + err.Error())
+	}
+	return nil
+}
+
+// FileStore is a Store keeping each pollaris as a JSON file in a directory,
+// for single node deployments, and each group definition as a JSON file in
+// its "groups" subdirectory. The files use the same format as the
+// ModelsDirectory files.
+type FileStore struct {
+	// dir is the directory holding the pollaris files
+	dir string
+}
+
+// NewFileStore creates a FileStore in the directory, creating it if needed.
+func NewFileStore(dir string) (*FileStore, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// fileOf returns the path of the file holding the named pollaris.
+func (this *FileStore) fileOf(name string) string {
+	return filepath.Join(this.dir, url.PathEscape(name)+".json")
+}
+
+// ForArea returns a FileStore in the "area-<area>" subdirectory, which is
+// created on the first save.
+func (this *FileStore) ForArea(area byte) Store {
+	return &FileStore{dir: filepath.Join(this.dir, "area-"+strconv.Itoa(int(area)))}
+}
+
+// Load reads every pollaris file in the directory. A directory that does not
+// exist yet holds no pollarises.
+func (this *FileStore) Load() ([]*l8tpollaris.L8Pollaris, error) {
+	_, err := os.Stat(this.dir)
+	if os.IsNotExist(err) {
+		return []*l8tpollaris.L8Pollaris{}, nil
+	}
+	return LoadModelsDirectory(this.dir)
+}
+
+// Save writes the pollaris to its file. The file is written to a temporary
+// file first and renamed, so a crash never leaves a partially written file.
+func (this *FileStore) Save(l8pollaris *l8tpollaris.L8Pollaris) error {
+	return writeJson(this.dir, this.fileOf(l8pollaris.Name), l8pollaris)
+}
+
+// groupsDir returns the directory holding the group definition files.
+func (this *FileStore) groupsDir() string {
+	return filepath.Join(this.dir, "groups")
+}
+
+// LoadGroups reads every group definition file in the groups subdirectory.
+// A subdirectory that does not exist yet holds no group definitions.
+func (this *FileStore) LoadGroups() ([]*l8tpollaris.L8PollarisGroupDefinition, error) {
+	_, err := os.Stat(this.groupsDir())
+	if os.IsNotExist(err) {
+		return []*l8tpollaris.L8PollarisGroupDefinition{}, nil
+	}
+	_, definitions, err := loadModelsDirectory(this.groupsDir())
+	return definitions, err
+}
+
+// SaveGroup writes the group definition to its file in the groups
+// subdirectory, the same way Save writes a pollaris.
+func (this *FileStore) SaveGroup(definition *l8tpollaris.L8PollarisGroupDefinition) error {
+	path := filepath.Join(this.groupsDir(), url.PathEscape(definition.Group)+".json")
+	return writeJson(this.groupsDir(), path, definition)
+}
+
+// writeJson writes the message as JSON to a temporary file in the directory,
+// creating it if needed, and renames it to the path.
+func writeJson(dir, path string, message proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(message)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	err = os.WriteFile(tmp, data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Delete removes the file of the named pollaris, or its tombstone, so it is
+// no longer persisted at all. Deleting a pollaris that is not persisted is
+// not an error.
+func (this *FileStore) Delete(name string) error {
+	err := os.Remove(this.fileOf(name))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// OrmStore is a Store keeping the pollarises and the group definitions in a
+// database through the ORM, like the Targets service does. The rows of a
+// tenant area share the tables of the global area, their key being prefixed
+// with "area-<area>/", see ForArea.
+type OrmStore struct {
+	// iorm is the ORM used to read and write the pollarises
+	iorm common.IORM
+	// resources provides the registry and introspector to the ORM
+	resources ifs.IResources
+	// prefix is the key prefix of the rows of the store area, empty for the
+	// global area
+	prefix string
+}
+
+// areaKey matches the key of a row of a tenant area.
+var areaKey = regexp.MustCompile(`^area-[0-9]+/`)
+
+// NewOrmStore creates an OrmStore on top of an ORM.
+func NewOrmStore(iorm common.IORM, resources ifs.IResources) *OrmStore {
+	resources.Registry().Register(&l8tpollaris.L8Pollaris{})
+	resources.Introspector().Decorators().AddPrimaryKeyDecorator(&l8tpollaris.L8Pollaris{}, "Name")
+	resources.Registry().Register(&l8tpollaris.L8PollarisGroupDefinition{})
+	resources.Introspector().Decorators().AddPrimaryKeyDecorator(&l8tpollaris.L8PollarisGroupDefinition{}, "Group")
+	return &OrmStore{iorm: iorm, resources: resources}
+}
+
+// NewPostgresStore creates an OrmStore on top of a PostgreSQL database.
+func NewPostgresStore(db *sql.DB, resources ifs.IResources) *OrmStore {
+	return NewOrmStore(postgres.NewPostgres(db, resources), resources)
+}
+
+// ForArea returns an OrmStore on the same database whose rows are keyed
+// with the "area-<area>/" prefix, so the pollarises and group definitions of
+// each service area are kept apart.
+func (this *OrmStore) ForArea(area byte) Store {
+	return &OrmStore{iorm: this.iorm, resources: this.resources, prefix: "area-" + strconv.Itoa(int(area)) + "/"}
+}
+
+// Load reads the pollarises of the store area from the database, in pages of 500.
+func (this *OrmStore) Load() ([]*l8tpollaris.L8Pollaris, error) {
+	elems, err := this.readAll("L8Pollaris")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*l8tpollaris.L8Pollaris, 0, len(elems))
+	for _, elem := range elems {
+		l8pollaris, ok := elem.(*l8tpollaris.L8Pollaris)
+		if ok && this.owns(l8pollaris.Name) {
+			l8pollaris.Name = l8pollaris.Name[len(this.prefix):]
+			result = append(result, l8pollaris)
+		}
+	}
+	return result, nil
+}
+
+// LoadGroups reads the group definitions of the store area from the
+// database, in pages of 500.
+func (this *OrmStore) LoadGroups() ([]*l8tpollaris.L8PollarisGroupDefinition, error) {
+	elems, err := this.readAll("L8PollarisGroupDefinition")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*l8tpollaris.L8PollarisGroupDefinition, 0, len(elems))
+	for _, elem := range elems {
+		definition, ok := elem.(*l8tpollaris.L8PollarisGroupDefinition)
+		if ok && this.owns(definition.Group) {
+			definition.Group = definition.Group[len(this.prefix):]
+			result = append(result, definition)
+		}
+	}
+	return result, nil
+}
+
+// owns reports whether the row key belongs to the store area.
+func (this *OrmStore) owns(key string) bool {
+	if this.prefix == "" {
+		return !areaKey.MatchString(key)
+	}
+	return strings.HasPrefix(key, this.prefix)
+}
+
+// readAll reads all the rows of the type from the database, in pages of 500.
+func (this *OrmStore) readAll(typeName string) ([]interface{}, error) {
+	gsql := "select * from " + typeName + " limit 500 page "
+	result := make([]interface{}, 0)
+	for page := 0; ; page++ {
+		buff := bytes.Buffer{}
+		buff.WriteString(gsql)
+		buff.WriteString(strconv.Itoa(page))
+		q, err := interpreter.NewQuery(buff.String(), this.resources)
+		if err != nil {
+			return nil, err
+		}
+		resp := this.iorm.Read(q, this.resources)
+		if resp.Error() != nil {
+			return nil, resp.Error()
+		}
+		if resp.Elements() == nil || len(resp.Elements()) == 0 || resp.Element() == nil {
+			break
+		}
+		result = append(result, resp.Elements()...)
+	}
+	return result, nil
+}
+
+// Save writes the pollaris to the database, keyed in the store area.
+func (this *OrmStore) Save(l8pollaris *l8tpollaris.L8Pollaris) error {
+	if this.prefix != "" {
+		l8pollaris = proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+		l8pollaris.Name = this.prefix + l8pollaris.Name
+	}
+	return this.iorm.Write(ifs.PUT, object.New(nil, l8pollaris), this.resources)
+}
+
+// SaveGroup writes the group definition to the database, keyed in the store area.
+func (this *OrmStore) SaveGroup(definition *l8tpollaris.L8PollarisGroupDefinition) error {
+	if this.prefix != "" {
+		definition = proto.Clone(definition).(*l8tpollaris.L8PollarisGroupDefinition)
+		definition.Group = this.prefix + definition.Group
+	}
+	return this.iorm.Write(ifs.PUT, object.New(nil, definition), this.resources)
+}
+
+// Delete removes the named pollaris, or its tombstone, of the store area
+// from the database, so it is no longer persisted at all.
+func (this *OrmStore) Delete(name string) error {
+	return this.iorm.Write(ifs.DELETE, object.New(nil, &l8tpollaris.L8Pollaris{Name: this.prefix + name}), this.resources)
+}
@@ -0,0 +1,63 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+)
+
+// Area returns the service area of the center, ServiceArea for the global one.
+func (this *PollarisCenter) Area() byte {
+	return this.area
+}
+
+// global returns the center of the global ServiceArea that a tenant center
+// falls back to, or nil for the global center itself or when the global
+// service is not activated.
+func (this *PollarisCenter) global() *PollarisCenter {
+	if this.area == ServiceArea || this.resources == nil {
+		return nil
+	}
+	global := PollarisOfArea(this.resources, ServiceArea)
+	if global == this {
+		return nil
+	}
+	return global
+}
+
+// resolveDefinition returns the stored definition of the named pollaris,
+// falling back to the global ServiceArea when a tenant center does not
+// define it. Returns nil if the pollaris does not exist.
+func (this *PollarisCenter) resolveDefinition(name string) *l8tpollaris.L8Pollaris {
+	definition := this.Definition(name)
+	if definition != nil {
+		return definition
+	}
+	if global := this.global(); global != nil {
+		return global.Definition(name)
+	}
+	return nil
+}
+
+// groupMembers returns the names of the pollarises in the group.
+func (this *PollarisCenter) groupMembers(groupName string) []string {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	group := this.groups[groupName]
+	result := make([]string, 0, len(group))
+	for _, name := range group {
+		result = append(result, name)
+	}
+	return result
+}
@@ -13,7 +13,10 @@
 
 package pollaris
 
-import "github.com/saichler/l8utils/go/utils/strings"
+import (
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8utils/go/utils/strings"
+)
 
 // pollarisKey generates a composite key from the given pollaris attributes.
 // The key is constructed by concatenating non-empty attributes with '+'
@@ -32,6 +35,20 @@ func pollarisKey(name, vendor, series, family, software, hardware, version strin
 	return buff.String()
 }
 
+// keyOf generates a composite key from positional arguments in the order
+// name, vendor, series, family, software, hardware, version. Trailing
+// arguments may be omitted to build a less specific key.
+func keyOf(args ...string) string {
+	buff := strings.New()
+	if len(args) > 0 {
+		buff.Add(args[0])
+	}
+	for i := 1; i < len(args); i++ {
+		addToKey(args[i], buff)
+	}
+	return buff.String()
+}
+
 // addToKey appends a string to the key buffer with a '+' separator.
 // Empty strings are ignored to allow optional key components.
 func addToKey(str string, buff *strings.String) {
@@ -40,3 +57,28 @@ func addToKey(str string, buff *strings.String) {
 		buff.Add(str)
 	}
 }
+
+// hasDeviceAttributes reports whether any of the device classification
+// attributes (vendor, series, family, software, hardware, version) is set.
+func hasDeviceAttributes(l8pollaris *l8tpollaris.L8Pollaris) bool {
+	return l8pollaris.Vendor != "" || l8pollaris.Series != "" || l8pollaris.Family != "" ||
+		l8pollaris.Software != "" || l8pollaris.Hardware != "" || l8pollaris.Version != ""
+}
+
+// pageOf returns the slice of the list that falls on the requested page.
+// A limit of zero or less disables paging and returns the whole list.
+// Pages are zero based; a page beyond the end of the list returns an empty slice.
+func pageOf(list []*l8tpollaris.L8Pollaris, limit, page int32) []*l8tpollaris.L8Pollaris {
+	if limit <= 0 {
+		return list
+	}
+	start := int(limit) * int(page)
+	if start < 0 || start >= len(list) {
+		return []*l8tpollaris.L8Pollaris{}
+	}
+	end := start + int(limit)
+	if end > len(list) {
+		end = len(list)
+	}
+	return list[start:end]
+}
@@ -0,0 +1,302 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/saichler/l8pollaris/go/pollaris/condition"
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// ValidationError is a single problem found in a pollaris, located by the
+// path of the offending field, e.g. "polling[sysinfo].cadence.cadences[1]".
+type ValidationError struct {
+	// Path is the path of the offending field within the pollaris
+	Path string
+	// Message describes the problem
+	Message string
+}
+
+// ValidationErrors is the list of problems found in a pollaris.
+// It implements error so it can be returned from Post and Put as is.
+type ValidationErrors []*ValidationError
+
+// Error renders all the problems as "path: message" separated by "; ".
+func (this ValidationErrors) Error() string {
+	buff := strings.Builder{}
+	for i, err := range this {
+		if i > 0 {
+			buff.WriteString("; ")
+		}
+		buff.WriteString(err.Path)
+		buff.WriteString(": ")
+		buff.WriteString(err.Message)
+	}
+	return buff.String()
+}
+
+// Validator checks the semantics of a pollaris and reports the problems found.
+type Validator interface {
+	Validate(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors
+}
+
+// ValidatorFunc adapts a function to the Validator interface.
+type ValidatorFunc func(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors
+
+// Validate calls the function.
+func (this ValidatorFunc) Validate(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors {
+	return this(l8pollaris, resources)
+}
+
+// RuleRegistry tells whether a parsing rule name is known to the parser.
+type RuleRegistry interface {
+	Exists(name string) bool
+}
+
+// Rules overrides the parser rule registry used to validate attribute rule
+// names. When nil, the rules implemented by the l8parser are used, see
+// ParserRules. This should be set by the application before calling Activate.
+var Rules RuleRegistry
+
+// SupportedOperations lists the operations each protocol can perform.
+// Applications may extend it when a collector adds support for a combination.
+var SupportedOperations = map[l8tpollaris.L8PProtocol][]l8tpollaris.L8C_Operation{
+	l8tpollaris.L8PProtocol_L8PSSH:      {l8tpollaris.L8C_Operation_L8C_Get, l8tpollaris.L8C_Operation_L8C_Map},
+	l8tpollaris.L8PProtocol_L8PPSNMPV2:  {l8tpollaris.L8C_Operation_L8C_Get, l8tpollaris.L8C_Operation_L8C_Map, l8tpollaris.L8C_Operation_L8C_Table},
+	l8tpollaris.L8PProtocol_L8PSNMPV3:   {l8tpollaris.L8C_Operation_L8C_Get, l8tpollaris.L8C_Operation_L8C_Map, l8tpollaris.L8C_Operation_L8C_Table},
+	l8tpollaris.L8PProtocol_L8PRESTCONF: {l8tpollaris.L8C_Operation_L8C_Get, l8tpollaris.L8C_Operation_L8C_Map},
+	l8tpollaris.L8PProtocol_L8PNETCONF:  {l8tpollaris.L8C_Operation_L8C_Get, l8tpollaris.L8C_Operation_L8C_Map},
+	l8tpollaris.L8PProtocol_L8PGRPC:     {l8tpollaris.L8C_Operation_L8C_Get, l8tpollaris.L8C_Operation_L8C_Map},
+	l8tpollaris.L8PProtocol_L8PKubectl:  {l8tpollaris.L8C_Operation_L8C_Get, l8tpollaris.L8C_Operation_L8C_Map},
+	l8tpollaris.L8PProtocol_L8PGraphQL:  {l8tpollaris.L8C_Operation_L8C_Get, l8tpollaris.L8C_Operation_L8C_Map},
+}
+
+// validators holds the registered validators by name.
+var validators = map[string]Validator{
+	"operation": ValidatorFunc(validateOperations),
+	"cadence":   ValidatorFunc(validateCadences),
+	"types":     ValidatorFunc(validateTypes),
+	"rules":     ValidatorFunc(validateRules),
+	"snmp":      ValidatorFunc(validateOids),
+	"condition": ValidatorFunc(validateConditions),
+}
+
+// validatorsMtx protects the validators map.
+var validatorsMtx = &sync.RWMutex{}
+
+// RegisterValidator adds, or replaces, a named validator that is run on every
+// pollaris posted or put into a PollarisCenter. The built-in validators are
+// registered as "operation", "cadence", "types", "rules", "snmp" and
+// "condition".
+func RegisterValidator(name string, validator Validator) {
+	validatorsMtx.Lock()
+	defer validatorsMtx.Unlock()
+	validators[name] = validator
+}
+
+// UnregisterValidator removes a named validator.
+func UnregisterValidator(name string) {
+	validatorsMtx.Lock()
+	defer validatorsMtx.Unlock()
+	delete(validators, name)
+}
+
+// Validate runs every registered validator on the pollaris, in validator
+// name order, and returns all the problems found, or nil if there are none.
+func Validate(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors {
+	validatorsMtx.RLock()
+	names := make([]string, 0, len(validators))
+	for name := range validators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	list := make([]Validator, 0, len(names))
+	for _, name := range names {
+		list = append(list, validators[name])
+	}
+	validatorsMtx.RUnlock()
+
+	var result ValidationErrors
+	for _, validator := range list {
+		result = append(result, validator.Validate(l8pollaris, resources)...)
+	}
+	return result
+}
+
+// pollPath returns the path of a poll within a pollaris.
+func pollPath(pollName string) string {
+	return "polling[" + pollName + "]"
+}
+
+// sortedPollNames returns the poll names of the pollaris in order, so the
+// reported problems are deterministic.
+func sortedPollNames(l8pollaris *l8tpollaris.L8Pollaris) []string {
+	names := make([]string, 0, len(l8pollaris.Polling))
+	for name := range l8pollaris.Polling {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validateOperations checks that the protocol of every poll supports its
+// operation. A poll without a protocol or an operation is left to the
+// collector defaults and is not checked.
+func validateOperations(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors {
+	var result ValidationErrors
+	for _, pollName := range sortedPollNames(l8pollaris) {
+		poll := l8pollaris.Polling[pollName]
+		if poll.Protocol == l8tpollaris.L8PProtocol_L8PInvalid_Protocol ||
+			poll.Operation == l8tpollaris.L8C_Operation_Invalid_Operation {
+			continue
+		}
+		supported := false
+		for _, operation := range SupportedOperations[poll.Protocol] {
+			if operation == poll.Operation {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			result = append(result, &ValidationError{pollPath(pollName) + ".operation",
+				poll.Operation.String() + " is not supported over " + poll.Protocol.String()})
+		}
+	}
+	return result
+}
+
+// validateCadences checks that the timeout is not negative and that a cadence
+// plan, when set, has positive cadences, non negative startups and a current
+// index within the cadences.
+func validateCadences(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors {
+	var result ValidationErrors
+	for _, pollName := range sortedPollNames(l8pollaris) {
+		poll := l8pollaris.Polling[pollName]
+		path := pollPath(pollName)
+		if poll.Timeout < 0 {
+			result = append(result, &ValidationError{path + ".timeout", "timeout is negative"})
+		}
+		if poll.Cadence == nil {
+			continue
+		}
+		if len(poll.Cadence.Cadences) == 0 {
+			result = append(result, &ValidationError{path + ".cadence.cadences", "cadence plan has no cadences"})
+		}
+		for i, cadence := range poll.Cadence.Cadences {
+			if cadence <= 0 {
+				result = append(result, &ValidationError{path + ".cadence.cadences[" + strconv.Itoa(i) + "]",
+					"cadence must be positive"})
+			}
+		}
+		for i, startup := range poll.Cadence.Startups {
+			if startup < 0 {
+				result = append(result, &ValidationError{path + ".cadence.startups[" + strconv.Itoa(i) + "]",
+					"startup must not be negative"})
+			}
+		}
+		if poll.Cadence.Current < 0 || (len(poll.Cadence.Cadences) > 0 && int(poll.Cadence.Current) >= len(poll.Cadence.Cadences)) {
+			result = append(result, &ValidationError{path + ".cadence.current", "current is out of the cadences range"})
+		}
+	}
+	return result
+}
+
+// validateTypes checks that the bodyName and respName of every poll are
+// types registered in the resources registry.
+func validateTypes(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors {
+	if resources == nil {
+		return nil
+	}
+	var result ValidationErrors
+	for _, pollName := range sortedPollNames(l8pollaris) {
+		poll := l8pollaris.Polling[pollName]
+		if poll.BodyName != "" {
+			_, err := resources.Registry().Info(poll.BodyName)
+			if err != nil {
+				result = append(result, &ValidationError{pollPath(pollName) + ".bodyName",
+					poll.BodyName + " is not a registered type"})
+			}
+		}
+		if poll.RespName != "" {
+			_, err := resources.Registry().Info(poll.RespName)
+			if err != nil {
+				result = append(result, &ValidationError{pollPath(pollName) + ".respName",
+					poll.RespName + " is not a registered type"})
+			}
+		}
+	}
+	return result
+}
+
+// validateRules checks that every attribute rule name is known to the parser
+// rule registry, Rules or by default the l8parser rules.
+func validateRules(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors {
+	var registry RuleRegistry = NewParserRules(resources)
+	if Rules != nil {
+		registry = Rules
+	}
+	var result ValidationErrors
+	for _, pollName := range sortedPollNames(l8pollaris) {
+		poll := l8pollaris.Polling[pollName]
+		for i, attr := range poll.Attributes {
+			for j, rule := range attr.Rules {
+				if !registry.Exists(rule.Name) {
+					result = append(result, &ValidationError{pollPath(pollName) + ".attributes[" + strconv.Itoa(i) +
+						"].rules[" + strconv.Itoa(j) + "].name", "unknown rule " + rule.Name})
+				}
+			}
+		}
+	}
+	return result
+}
+
+// oidPattern matches a dotted numeric SNMP OID, with an optional leading dot.
+var oidPattern = regexp.MustCompile(`^\.?[0-9]+(\.[0-9]+)+$`)
+
+// validateOids checks that the What of every SNMP poll is a well formed OID.
+func validateOids(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors {
+	var result ValidationErrors
+	for _, pollName := range sortedPollNames(l8pollaris) {
+		poll := l8pollaris.Polling[pollName]
+		if poll.Protocol != l8tpollaris.L8PProtocol_L8PPSNMPV2 && poll.Protocol != l8tpollaris.L8PProtocol_L8PSNMPV3 {
+			continue
+		}
+		if !oidPattern.MatchString(poll.What) {
+			result = append(result, &ValidationError{pollPath(pollName) + ".what", poll.What + " is not a valid OID"})
+		}
+	}
+	return result
+}
+
+// validateConditions checks that the condition of every poll is well formed.
+func validateConditions(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) ValidationErrors {
+	var result ValidationErrors
+	for _, pollName := range sortedPollNames(l8pollaris) {
+		poll := l8pollaris.Polling[pollName]
+		if poll.Condition == "" {
+			continue
+		}
+		_, err := condition.Compile(poll.Condition)
+		if err != nil {
+			result = append(result, &ValidationError{pollPath(pollName) + ".condition", err.Error()})
+		}
+	}
+	return result
+}
@@ -0,0 +1,290 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pollaris
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// versionConstraint is a single comparison of a version against a bound.
+type versionConstraint struct {
+	// op is one of "=", "!=", ">", ">=", "<", "<=", "^", "~" or "x"
+	op string
+	// bound is the parsed version the device version is compared with
+	bound []int
+}
+
+// isVersionRange reports whether the version attribute is a range expression
+// rather than a literal version.
+func isVersionRange(pattern string) bool {
+	if strings.ContainsAny(pattern, "<>=^~*| ") {
+		return true
+	}
+	for _, part := range strings.Split(pattern, ".") {
+		if part == "x" || part == "X" {
+			return true
+		}
+	}
+	return false
+}
+
+// parseVersionRange parses a version range expression into alternatives of
+// constraints. Alternatives are separated by "||" and the space separated
+// constraints of an alternative must all hold. A constraint is a version
+// prefixed by one of the operators =, !=, >, >=, <, <=, ^ (same major
+// version) or ~ (same major and minor version), or a version with a
+// wildcard component such as "17.x" or "17.3.*".
+func parseVersionRange(pattern string) ([][]*versionConstraint, error) {
+	result := make([][]*versionConstraint, 0)
+	for _, alternative := range strings.Split(pattern, "||") {
+		constraints := make([]*versionConstraint, 0)
+		for _, text := range strings.Fields(alternative) {
+			constraint, err := parseVersionConstraint(text)
+			if err != nil {
+				return nil, err
+			}
+			constraints = append(constraints, constraint)
+		}
+		if len(constraints) == 0 {
+			return nil, errors.New("empty version range in " + pattern)
+		}
+		result = append(result, constraints)
+	}
+	return result, nil
+}
+
+// parseVersionConstraint parses a single constraint of a version range.
+func parseVersionConstraint(text string) (*versionConstraint, error) {
+	for _, op := range []string{">=", "<=", "!=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(text, op) {
+			bound, ok := parseVersion(text[len(op):])
+			if !ok {
+				return nil, errors.New("invalid version " + text)
+			}
+			return &versionConstraint{op: op, bound: bound}, nil
+		}
+	}
+	parts := strings.Split(text, ".")
+	for i, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			if i == 0 {
+				return &versionConstraint{op: "x", bound: []int{}}, nil
+			}
+			bound, ok := parseVersion(strings.Join(parts[:i], "."))
+			if !ok {
+				return nil, errors.New("invalid version " + text)
+			}
+			return &versionConstraint{op: "x", bound: bound}, nil
+		}
+	}
+	bound, ok := parseVersion(text)
+	if !ok {
+		return nil, errors.New("invalid version " + text)
+	}
+	return &versionConstraint{op: "=", bound: bound}, nil
+}
+
+// matchVersionRange reports whether the device version satisfies the range,
+// and how narrowly, see spanOf. When several alternatives hold, the
+// narrowest one is reported. An unparsable range or device version never
+// matches.
+func matchVersionRange(pattern, value string) (bool, precision) {
+	alternatives, err := parseVersionRange(pattern)
+	if err != nil {
+		return false, precision{}
+	}
+	version, ok := parseVersion(value)
+	if !ok {
+		return false, precision{}
+	}
+	matched := false
+	var best precision
+	for _, constraints := range alternatives {
+		all := true
+		for _, constraint := range constraints {
+			if !constraint.match(version) {
+				all = false
+				break
+			}
+		}
+		if !all {
+			continue
+		}
+		p := spanOf(constraints)
+		if !matched || p.narrower(best) {
+			best = p
+		}
+		matched = true
+	}
+	return matched, best
+}
+
+// spanOf returns the precision of an alternative of a version range. An
+// alternative made only of wildcard versions, such as "17.x", is a wildcard,
+// any other is a range. An alternative without an upper bound has an
+// infinite width; a bounded one has the span of versions between its lower
+// and upper bound, see spanBetween.
+func spanOf(constraints []*versionConstraint) precision {
+	lower, upper := []int{}, []int(nil)
+	tier := precisionWildcard
+	for _, constraint := range constraints {
+		if constraint.op != "x" {
+			tier = precisionRange
+		}
+		from, to := lower, upper
+		switch constraint.op {
+		case "=":
+			from, to = constraint.bound, constraint.bound
+		case ">", ">=":
+			from = constraint.bound
+		case "<", "<=":
+			to = constraint.bound
+		case "^":
+			from, to = constraint.bound, nextVersion(constraint.bound, 1)
+		case "~":
+			from, to = constraint.bound, nextVersion(constraint.bound, 2)
+		case "x":
+			if len(constraint.bound) > 0 {
+				from, to = constraint.bound, nextVersion(constraint.bound, len(constraint.bound))
+			}
+		}
+		if compareVersions(from, lower) > 0 {
+			lower = from
+		}
+		if to != nil && (upper == nil || compareVersions(to, upper) < 0) {
+			upper = to
+		}
+	}
+	if upper == nil {
+		return precision{tier: tier, width: math.Inf(1)}
+	}
+	return precision{tier: tier, span: spanBetween(lower, upper)}
+}
+
+// nextVersion returns the first version after every version sharing the
+// given number of leading components with the bound, e.g. 18 for 17.3 and 1.
+func nextVersion(bound []int, components int) []int {
+	next := make([]int, components)
+	for i := 0; i < components; i++ {
+		next[i] = component(bound, i)
+	}
+	next[components-1]++
+	return next
+}
+
+// spanBetween returns the differences of the components of the upper and
+// the lower bound of a range. Spans compare component by component, like
+// versions, so a component of any size never overflows into the next one,
+// e.g. 17.3.1024 to 17.4 is narrower than 17.3 to 18.
+func spanBetween(lower, upper []int) []int {
+	size := len(lower)
+	if len(upper) > size {
+		size = len(upper)
+	}
+	span := make([]int, size)
+	for i := 0; i < size; i++ {
+		span[i] = component(upper, i) - component(lower, i)
+	}
+	return span
+}
+
+// match reports whether the version satisfies the constraint.
+func (this *versionConstraint) match(version []int) bool {
+	cmp := compareVersions(version, this.bound)
+	switch this.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case "^":
+		return cmp >= 0 && component(version, 0) == component(this.bound, 0)
+	case "~":
+		return cmp >= 0 && component(version, 0) == component(this.bound, 0) &&
+			component(version, 1) == component(this.bound, 1)
+	case "x":
+		for i := range this.bound {
+			if component(version, i) != this.bound[i] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// parseVersion parses a dotted version such as "17.3.1" into its numeric
+// components. A leading "v" is ignored, and so are non numeric suffixes of a
+// component, so "17.3.1a" parses as 17.3.1.
+// Returns false if a component does not start with a digit.
+func parseVersion(text string) ([]int, bool) {
+	text = strings.TrimPrefix(strings.TrimPrefix(text, "v"), "V")
+	if text == "" {
+		return nil, false
+	}
+	parts := strings.Split(text, ".")
+	result := make([]int, 0, len(parts))
+	for _, part := range parts {
+		end := 0
+		for end < len(part) && part[end] >= '0' && part[end] <= '9' {
+			end++
+		}
+		if end == 0 {
+			return nil, false
+		}
+		n, err := strconv.Atoi(part[:end])
+		if err != nil {
+			return nil, false
+		}
+		result = append(result, n)
+	}
+	return result, true
+}
+
+// compareVersions compares two parsed versions component by component,
+// treating missing components as zero. Returns -1, 0 or 1.
+func compareVersions(a, b []int) int {
+	size := len(a)
+	if len(b) > size {
+		size = len(b)
+	}
+	for i := 0; i < size; i++ {
+		ca, cb := component(a, i), component(b, i)
+		if ca < cb {
+			return -1
+		}
+		if ca > cb {
+			return 1
+		}
+	}
+	return 0
+}
+
+// component returns the i-th component of a parsed version, or zero if missing.
+func component(version []int, i int) int {
+	if i < len(version) {
+		return version[i]
+	}
+	return 0
+}
@@ -0,0 +1,242 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redactedValue replaces the values of the redacted fields in the changes.
+const redactedValue = "<redacted>"
+
+// Redacted holds the full names of the fields, and of the message types, whose
+// values are never written to the audit trail; their changes are recorded
+// with redacted values. By default it covers the target credentials.
+var Redacted = map[string]bool{
+	"l8tpollaris.AuthInfo":             true,
+	"l8tpollaris.L8PHostProtocol.cert": true,
+}
+
+// differ accumulates the changes found while comparing two messages.
+type differ struct {
+	// changes are the changes found, in comparison order
+	changes []*l8tpollaris.L8PChange
+}
+
+// Diff compares two messages of the same type field by field and returns the
+// changes from before to after, with paths built from the JSON field names,
+// such as "hosts[h1].configs[1].addr". List elements are compared by index
+// and map entries by key. A nil message compares as empty.
+func Diff(before, after proto.Message) []*l8tpollaris.L8PChange {
+	d := &differ{changes: make([]*l8tpollaris.L8PChange, 0)}
+	b, a := reflectOf(before), reflectOf(after)
+	switch {
+	case b == nil && a == nil:
+		return d.changes
+	case b == nil:
+		b = a.Type().Zero()
+	case a == nil:
+		a = b.Type().Zero()
+	}
+	d.message("", b, a)
+	return d.changes
+}
+
+// reflectOf returns the reflection of a message, or nil for a nil message.
+func reflectOf(message proto.Message) protoreflect.Message {
+	if message == nil || !message.ProtoReflect().IsValid() {
+		return nil
+	}
+	return message.ProtoReflect()
+}
+
+// add records a change of type at path.
+func (this *differ) add(typ l8tpollaris.L8PChangeType, path, oldValue, newValue string) {
+	this.changes = append(this.changes, &l8tpollaris.L8PChange{Type: typ, Path: path,
+		OldValue: oldValue, NewValue: newValue})
+}
+
+// value records a change of a single value, as added when it was empty,
+// removed when it becomes empty and changed otherwise.
+func (this *differ) value(path, oldValue, newValue string) {
+	switch {
+	case oldValue == newValue:
+	case oldValue == "":
+		this.add(l8tpollaris.L8PChangeType_L8PChange_Added, path, oldValue, newValue)
+	case newValue == "":
+		this.add(l8tpollaris.L8PChangeType_L8PChange_Removed, path, oldValue, newValue)
+	default:
+		this.add(l8tpollaris.L8PChangeType_L8PChange_Changed, path, oldValue, newValue)
+	}
+}
+
+// message records the changes of every field of two messages of the same type.
+func (this *differ) message(path string, before, after protoreflect.Message) {
+	fields := before.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		fieldPath := join(path, fd.JSONName())
+		if redacted(fd) {
+			this.redacted(fieldPath, fd, before, after)
+			continue
+		}
+		switch {
+		case fd.IsList():
+			this.list(fieldPath, fd, before.Get(fd).List(), after.Get(fd).List())
+		case fd.IsMap():
+			this.mapOf(fieldPath, fd, before.Get(fd).Map(), after.Get(fd).Map())
+		case fd.Message() != nil:
+			if before.Has(fd) || after.Has(fd) {
+				this.message(fieldPath, before.Get(fd).Message(), after.Get(fd).Message())
+			}
+		default:
+			this.value(fieldPath, scalarOf(before, fd), scalarOf(after, fd))
+		}
+	}
+}
+
+// list records the changes between two lists, compared by index.
+func (this *differ) list(path string, fd protoreflect.FieldDescriptor, before, after protoreflect.List) {
+	for i := 0; i < before.Len() || i < after.Len(); i++ {
+		elemPath := path + "[" + strconv.Itoa(i) + "]"
+		if fd.Message() != nil {
+			b, a := elementOf(before, i), elementOf(after, i)
+			this.message(elemPath, b, a)
+			continue
+		}
+		oldValue, newValue := "", ""
+		if i < before.Len() {
+			oldValue = valueString(fd, before.Get(i))
+		}
+		if i < after.Len() {
+			newValue = valueString(fd, after.Get(i))
+		}
+		this.value(elemPath, oldValue, newValue)
+	}
+}
+
+// elementOf returns the message at index i of the list, or an empty message
+// when the list is shorter.
+func elementOf(list protoreflect.List, i int) protoreflect.Message {
+	if i < list.Len() {
+		return list.Get(i).Message()
+	}
+	return list.NewElement().Message().Type().Zero()
+}
+
+// mapOf records the changes between two maps, compared by key.
+func (this *differ) mapOf(path string, fd protoreflect.FieldDescriptor, before, after protoreflect.Map) {
+	keys := make(map[string]protoreflect.MapKey)
+	before.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[key.String()] = key
+		return true
+	})
+	after.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[key.String()] = key
+		return true
+	})
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	valueFd := fd.MapValue()
+	for _, name := range names {
+		key := keys[name]
+		entryPath := path + "[" + name + "]"
+		if valueFd.Message() != nil {
+			b, a := before.NewValue().Message().Type().Zero(), after.NewValue().Message().Type().Zero()
+			if before.Has(key) {
+				b = before.Get(key).Message()
+			}
+			if after.Has(key) {
+				a = after.Get(key).Message()
+			}
+			this.message(entryPath, b, a)
+			continue
+		}
+		oldValue, newValue := "", ""
+		if before.Has(key) {
+			oldValue = valueString(valueFd, before.Get(key))
+		}
+		if after.Has(key) {
+			newValue = valueString(valueFd, after.Get(key))
+		}
+		this.value(entryPath, oldValue, newValue)
+	}
+}
+
+// redacted records a change of a redacted field without its values.
+func (this *differ) redacted(path string, fd protoreflect.FieldDescriptor, before, after protoreflect.Message) {
+	if before.Has(fd) == after.Has(fd) && before.Get(fd).Equal(after.Get(fd)) {
+		return
+	}
+	oldValue, newValue := "", ""
+	if before.Has(fd) {
+		oldValue = redactedValue
+	}
+	if after.Has(fd) {
+		newValue = redactedValue
+	}
+	if oldValue == newValue {
+		this.add(l8tpollaris.L8PChangeType_L8PChange_Changed, path, oldValue, newValue)
+		return
+	}
+	this.value(path, oldValue, newValue)
+}
+
+// redacted reports whether the values of the field must not be recorded.
+func redacted(fd protoreflect.FieldDescriptor) bool {
+	if Redacted[string(fd.FullName())] {
+		return true
+	}
+	return fd.Message() != nil && Redacted[string(fd.Message().FullName())]
+}
+
+// scalarOf renders the value of a scalar field, or an empty string when unset.
+func scalarOf(message protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	if !message.Has(fd) {
+		return ""
+	}
+	return valueString(fd, message.Get(fd))
+}
+
+// valueString renders a scalar value, using the value name for enums.
+func valueString(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		enumValue := fd.Enum().Values().ByNumber(value.Enum())
+		if enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	case protoreflect.BytesKind:
+		return "bytes(" + strconv.Itoa(len(value.Bytes())) + ")"
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// join appends a field name to a path.
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
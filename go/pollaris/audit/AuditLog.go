@@ -0,0 +1,135 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit records an audit trail of the changes made to pollarises and
+// targets: who changed what, when, with which operation and the before/after
+// diff of the changed object. Records are kept in a durable Store and can be
+// queried by object, actor and time range through the Audit service.
+package audit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"google.golang.org/protobuf/proto"
+)
+
+// AuditStore is the durable store of the audit trail. Changes are audited
+// only when it is set, which should be done before activating the services.
+var AuditStore Store
+
+// Anonymous is the actor of the changes requested without a caller identity.
+const Anonymous = "anonymous"
+
+// Enabled reports whether changes are audited.
+func Enabled() bool {
+	return AuditStore != nil
+}
+
+// ActorOf returns the actor of a change requested by the identity, which is
+// Anonymous for an empty identity.
+func ActorOf(identity string) string {
+	if identity == "" {
+		return Anonymous
+	}
+	return identity
+}
+
+// Record appends a record of a change to the audit trail, with the diff from
+// before to after, where a nil before means the object was created and a nil
+// after means it was removed. A change that does not modify the object is
+// not recorded. Does nothing when auditing is not enabled.
+// Returns an error for a change without an actor, see ActorOf.
+func Record(actor, operation, objectType, objectId string, before, after proto.Message) error {
+	store := AuditStore
+	if store == nil {
+		return nil
+	}
+	if actor == "" {
+		return errors.New("Cannot audit " + operation + " of " + objectType + " " + objectId + " without an actor")
+	}
+	changes := Diff(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+	return store.Append(&l8tpollaris.L8PAuditRecord{Id: newId(), Actor: actor, Timestamp: time.Now().UnixNano(),
+		Operation: operation, ObjectType: objectType, ObjectId: objectId, Changes: changes})
+}
+
+// Query returns the audit records matching the query, oldest first and paged
+// by the query limit and page. Returns an empty list when auditing is not enabled.
+func Query(query *l8tpollaris.L8PAuditQuery) ([]*l8tpollaris.L8PAuditRecord, error) {
+	store := AuditStore
+	if store == nil {
+		return []*l8tpollaris.L8PAuditRecord{}, nil
+	}
+	return store.Query(query)
+}
+
+// Matches reports whether the record matches the filters of the query.
+func Matches(query *l8tpollaris.L8PAuditQuery, record *l8tpollaris.L8PAuditRecord) bool {
+	if query.ObjectType != "" && query.ObjectType != record.ObjectType {
+		return false
+	}
+	if query.ObjectId != "" && query.ObjectId != record.ObjectId {
+		return false
+	}
+	if query.Actor != "" && query.Actor != record.Actor {
+		return false
+	}
+	if query.From != 0 && record.Timestamp < query.From {
+		return false
+	}
+	if query.To != 0 && record.Timestamp > query.To {
+		return false
+	}
+	return true
+}
+
+// Select returns the records matching the query, sorted oldest first and
+// paged by the query limit and page. Stores that cannot filter natively use
+// it on all their records, the others on the records they filtered.
+func Select(query *l8tpollaris.L8PAuditQuery, records []*l8tpollaris.L8PAuditRecord) []*l8tpollaris.L8PAuditRecord {
+	result := make([]*l8tpollaris.L8PAuditRecord, 0)
+	for _, record := range records {
+		if Matches(query, record) {
+			result = append(result, record)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Timestamp < result[j].Timestamp
+	})
+	if query.Limit <= 0 {
+		return result
+	}
+	start := int(query.Limit) * int(query.Page)
+	if start >= len(result) {
+		return []*l8tpollaris.L8PAuditRecord{}
+	}
+	end := start + int(query.Limit)
+	if end > len(result) {
+		end = len(result)
+	}
+	return result[start:end]
+}
+
+// newId returns a random unique record id.
+func newId() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,161 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"errors"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8types/go/types/l8web"
+	"github.com/saichler/l8utils/go/utils/web"
+)
+
+const (
+	// ServiceName is the registered name of the Audit service in the service registry.
+	ServiceName = "Audit"
+	// ServiceArea defines the service area (partition) for the Audit service.
+	ServiceArea = byte(0)
+)
+
+// Authorize is the optional authorization of the audit trail queries. It
+// returns nil if the caller of the request may read the audit trail, or the
+// denial error otherwise. The Pollaris service, which authorizes the callers,
+// sets it when activated. When nil, every query is allowed.
+var Authorize func(pb ifs.IElements) error
+
+// Dispatch attaches the sender of the incoming message to the request and
+// calls the handler method of the message action, so Authorize knows the
+// caller. The Pollaris service sets it with Authorize when activated. When
+// nil, the request is handled without its sender.
+var Dispatch func(handler ifs.IServiceHandler, pb ifs.IElements, msg *ifs.Message, vnic ifs.IVNic) ifs.IElements
+
+// AuditService implements the IServiceHandler interface for querying the
+// audit trail. Records are appended by the audited services through Record
+// and are read only through this service.
+type AuditService struct {
+	// serviceArea stores the service area for this instance
+	serviceArea byte
+}
+
+// Activate registers the Audit service with the VNic, so the audit trail in
+// AuditStore can be queried with a POST of L8PAuditQuery.
+func Activate(vnic ifs.IVNic) error {
+	sla := ifs.NewServiceLevelAgreement(&AuditService{}, ServiceName, ServiceArea, false, nil)
+	sla.SetServiceItem(&l8tpollaris.L8PAuditRecord{})
+	sla.SetServiceItemList(&l8tpollaris.L8PAuditRecordList{})
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	return err
+}
+
+// Activate is called by the service framework to initialize this service instance.
+func (this *AuditService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PAuditRecord{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PAuditRecordList{})
+	vnic.Resources().Registry().Register(&l8tpollaris.L8PAuditQuery{})
+	this.serviceArea = sla.ServiceArea()
+	return nil
+}
+
+// DeActivate is called when the service is being shut down.
+func (this *AuditService) DeActivate() error {
+	return nil
+}
+
+// Post handles a L8PAuditQuery and returns it with the matching records.
+// Queries the caller is not permitted to make return the denial error.
+func (this *AuditService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, ok := pb.Element().(*l8tpollaris.L8PAuditQuery)
+	if !ok {
+		return object.New(errors.New("Element is not a L8PAuditQuery"), &l8tpollaris.L8PAuditQuery{})
+	}
+	if Authorize != nil {
+		err := Authorize(pb)
+		if err != nil {
+			return object.New(err, &l8tpollaris.L8PAuditQuery{})
+		}
+	}
+	records, err := Query(query)
+	if err != nil {
+		return object.New(err, &l8tpollaris.L8PAuditQuery{})
+	}
+	return object.New(nil, &l8tpollaris.L8PAuditQuery{ObjectType: query.ObjectType, ObjectId: query.ObjectId,
+		Actor: query.Actor, From: query.From, To: query.To, Limit: query.Limit, Page: query.Page, Records: records})
+}
+
+// Put is not supported, the audit trail is append only.
+func (this *AuditService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.New(errors.New("The audit trail is append only"), &l8web.L8Empty{})
+}
+
+// Patch is not supported, the audit trail is append only.
+func (this *AuditService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.New(errors.New("The audit trail is append only"), &l8web.L8Empty{})
+}
+
+// Delete is not supported, the audit trail is append only.
+func (this *AuditService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.New(errors.New("The audit trail is append only"), &l8web.L8Empty{})
+}
+
+// Get is not supported, the audit trail is queried with a POST of L8PAuditQuery.
+func (this *AuditService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.New(errors.New("Query the audit trail with a L8PAuditQuery POST"), &l8tpollaris.L8PAuditQuery{})
+}
+
+// GetCopy is not supported, see Get.
+func (this *AuditService) GetCopy(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.Get(pb, vnic)
+}
+
+// Handle is called by the service framework with the incoming message, see
+// Dispatch.
+func (this *AuditService) Handle(pb ifs.IElements, msg *ifs.Message, vnic ifs.IVNic) ifs.IElements {
+	if Dispatch != nil {
+		return Dispatch(this, pb, msg, vnic)
+	}
+	if msg == nil {
+		return this.Get(pb, vnic)
+	}
+	switch msg.Action() {
+	case ifs.POST:
+		return this.Post(pb, vnic)
+	case ifs.PUT:
+		return this.Put(pb, vnic)
+	case ifs.PATCH:
+		return this.Patch(pb, vnic)
+	case ifs.DELETE:
+		return this.Delete(pb, vnic)
+	}
+	return this.Get(pb, vnic)
+}
+
+// Failed handles failed message delivery. Currently not implemented - returns nil.
+func (this *AuditService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+// TransactionConfig returns nil, the service is not transactional.
+func (this *AuditService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+// WebService returns the web service configuration for the Audit service,
+// with a POST of L8PAuditQuery returning the matching records.
+func (this *AuditService) WebService() ifs.IWebService {
+	ws := web.New(ServiceName, this.serviceArea, 0)
+	ws.AddEndpoint(&l8tpollaris.L8PAuditQuery{}, ifs.POST, &l8tpollaris.L8PAuditQuery{})
+	return ws
+}
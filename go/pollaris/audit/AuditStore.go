@@ -0,0 +1,195 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/saichler/l8orm/go/orm/common"
+	"github.com/saichler/l8orm/go/orm/plugins/postgres"
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8ql/go/gsql/interpreter"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Store keeps the audit records durably. Records are only ever appended.
+type Store interface {
+	// Append adds a record to the audit trail
+	Append(record *l8tpollaris.L8PAuditRecord) error
+	// Query returns the records matching the query, see Select
+	Query(query *l8tpollaris.L8PAuditQuery) ([]*l8tpollaris.L8PAuditRecord, error)
+}
+
+// FileStore is a Store keeping the audit trail in a file, one JSON record per
+// line, for single node deployments.
+type FileStore struct {
+	// path is the path of the audit file
+	path string
+	// mtx serializes the appends and reads of the file
+	mtx *sync.Mutex
+}
+
+// NewFileStore creates a FileStore on the file, creating its directory if needed.
+func NewFileStore(path string) (*FileStore, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{path: path, mtx: &sync.Mutex{}}, nil
+}
+
+// Append writes the record as a line at the end of the file and syncs it.
+func (this *FileStore) Append(record *l8tpollaris.L8PAuditRecord) error {
+	data, err := protojson.Marshal(record)
+	if err != nil {
+		return err
+	}
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	file, err := os.OpenFile(this.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(append(data, '\n'))
+	if err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// Query reads the records of the file and selects the ones matching the query.
+// A line that cannot be parsed, such as one cut by a crash, is skipped.
+func (this *FileStore) Query(query *l8tpollaris.L8PAuditQuery) ([]*l8tpollaris.L8PAuditRecord, error) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	file, err := os.Open(this.path)
+	if os.IsNotExist(err) {
+		return []*l8tpollaris.L8PAuditRecord{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	records := make([]*l8tpollaris.L8PAuditRecord, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		record := &l8tpollaris.L8PAuditRecord{}
+		if protojson.Unmarshal(line, record) != nil {
+			continue
+		}
+		if Matches(query, record) {
+			records = append(records, record)
+		}
+	}
+	if scanner.Err() != nil {
+		return nil, scanner.Err()
+	}
+	return Select(query, records), nil
+}
+
+// OrmStore is a Store keeping the audit trail in a database through the ORM,
+// like the Targets service does.
+type OrmStore struct {
+	// iorm is the ORM used to read and write the records
+	iorm common.IORM
+	// resources provides the registry and introspector to the ORM
+	resources ifs.IResources
+}
+
+// NewOrmStore creates an OrmStore on top of an ORM.
+func NewOrmStore(iorm common.IORM, resources ifs.IResources) *OrmStore {
+	resources.Registry().Register(&l8tpollaris.L8PAuditRecord{})
+	resources.Introspector().Decorators().AddPrimaryKeyDecorator(&l8tpollaris.L8PAuditRecord{}, "Id")
+	return &OrmStore{iorm: iorm, resources: resources}
+}
+
+// NewPostgresStore creates an OrmStore on top of a PostgreSQL database.
+func NewPostgresStore(db *sql.DB, resources ifs.IResources) *OrmStore {
+	return NewOrmStore(postgres.NewPostgres(db, resources), resources)
+}
+
+// Append writes the record to the database.
+func (this *OrmStore) Append(record *l8tpollaris.L8PAuditRecord) error {
+	return this.iorm.Write(ifs.POST, object.New(nil, record), this.resources)
+}
+
+// Query reads the records matching the query filters from the database, in
+// pages of 500, and sorts and pages them by the query.
+func (this *OrmStore) Query(query *l8tpollaris.L8PAuditQuery) ([]*l8tpollaris.L8PAuditRecord, error) {
+	gsql := "select * from L8PAuditRecord" + whereOf(query) + " limit 500 page "
+	records := make([]*l8tpollaris.L8PAuditRecord, 0)
+	for page := 0; ; page++ {
+		buff := bytes.Buffer{}
+		buff.WriteString(gsql)
+		buff.WriteString(strconv.Itoa(page))
+		q, err := interpreter.NewQuery(buff.String(), this.resources)
+		if err != nil {
+			return nil, err
+		}
+		resp := this.iorm.Read(q, this.resources)
+		if resp.Error() != nil {
+			return nil, resp.Error()
+		}
+		if resp.Elements() == nil || len(resp.Elements()) == 0 || resp.Element() == nil {
+			break
+		}
+		for _, elem := range resp.Elements() {
+			record, ok := elem.(*l8tpollaris.L8PAuditRecord)
+			if ok {
+				records = append(records, record)
+			}
+		}
+	}
+	return Select(query, records), nil
+}
+
+// whereOf returns the GSQL where clause of the object, actor and time range
+// filters of the query, or an empty string when the query matches all records.
+func whereOf(query *l8tpollaris.L8PAuditQuery) string {
+	conditions := make([]string, 0)
+	if query.ObjectType != "" {
+		conditions = append(conditions, "ObjectType="+query.ObjectType)
+	}
+	if query.ObjectId != "" {
+		conditions = append(conditions, "ObjectId="+query.ObjectId)
+	}
+	if query.Actor != "" {
+		conditions = append(conditions, "Actor="+query.Actor)
+	}
+	if query.From != 0 {
+		conditions = append(conditions, "Timestamp>="+strconv.FormatInt(query.From, 10))
+	}
+	if query.To != 0 {
+		conditions = append(conditions, "Timestamp<="+strconv.FormatInt(query.To, 10))
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " where " + strings.Join(conditions, " and ")
+}
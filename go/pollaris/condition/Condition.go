@@ -0,0 +1,130 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package condition evaluates the conditions of polls, so collectors can skip
+// the polls that do not apply to a target, a host or the results collected
+// so far. A condition is a boolean expression over named values:
+//
+//	host.protocols contains 'NETCONF'
+//	target.inventoryType = 'Storage' and not result.sysDescr matches '^Linux'
+//	target.inventoryType in ('Storage', 'Hosts') or result.ifCount > 0
+//
+// Operands are value names or quoted string and number literals. A name may
+// have several values, and a comparison holds when it holds for any of them:
+// "=" and "!=" compare numerically when both sides are numbers and ignore
+// case otherwise, "<", "<=", ">" and ">=" compare numbers, "contains" matches
+// a substring ignoring case, "matches" a regular expression and "in" a list
+// of operands. A name alone holds when it has a non-empty value. Comparisons
+// are combined with "and", "or", "not" and parentheses.
+package condition
+
+import (
+	"sync"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+)
+
+// Context provides the values of the names a condition refers to.
+type Context interface {
+	// Lookup returns the values of the name, or nil if it has none
+	Lookup(name string) []string
+}
+
+// Expression is a parsed condition.
+type Expression interface {
+	// Eval evaluates the condition on the context
+	Eval(ctx Context) bool
+}
+
+// expressions caches the parsed conditions by text.
+var expressions = &sync.Map{}
+
+// Compile parses a condition, caching the result, so conditions can be
+// evaluated repeatedly for every host without being parsed again.
+func Compile(text string) (Expression, error) {
+	cached, ok := expressions.Load(text)
+	if ok {
+		return cached.(Expression), nil
+	}
+	expression, err := Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	expressions.Store(text, expression)
+	return expression, nil
+}
+
+// Evaluate compiles and evaluates a condition on the context. An empty
+// condition always holds. Returns an error if the condition is malformed.
+func Evaluate(text string, ctx Context) (bool, error) {
+	if text == "" {
+		return true, nil
+	}
+	expression, err := Compile(text)
+	if err != nil {
+		return false, err
+	}
+	return expression.Eval(ctx), nil
+}
+
+// Applies reports whether the poll should run in the context, which is when
+// it has no condition or its condition holds. Returns an error if the
+// condition of the poll is malformed.
+func Applies(poll *l8tpollaris.L8Poll, ctx Context) (bool, error) {
+	return Evaluate(poll.Condition, ctx)
+}
+
+// Vars is a Context holding the values by name.
+type Vars map[string][]string
+
+// Lookup returns the values of the name.
+func (this Vars) Lookup(name string) []string {
+	return this[name]
+}
+
+// Set replaces the values of the name.
+func (this Vars) Set(name string, values ...string) {
+	this[name] = values
+}
+
+// NewVars returns the context of a poll of a target host, with the prior
+// results by property id. Any of them may be nil. It provides:
+//   - target.id, target.linksId, target.state and target.inventoryType
+//   - host.id, host.protocols and host.addresses, from the host configs
+//   - result.<property id> for each prior result
+func NewVars(target *l8tpollaris.L8PTarget, host *l8tpollaris.L8PHost, results map[string]string) Vars {
+	vars := Vars{}
+	if target != nil {
+		vars.Set("target.id", target.TargetId)
+		vars.Set("target.linksId", target.LinksId)
+		vars.Set("target.state", target.State.String())
+		vars.Set("target.inventoryType", target.InventoryType.String())
+	}
+	if host != nil {
+		vars.Set("host.id", host.HostId)
+		protocols := make([]string, 0, len(host.Configs))
+		addresses := make([]string, 0, len(host.Configs))
+		for _, config := range host.Configs {
+			protocols = append(protocols, config.Protocol.String())
+			if config.Addr != "" {
+				addresses = append(addresses, config.Addr)
+			}
+		}
+		vars.Set("host.protocols", protocols...)
+		vars.Set("host.addresses", addresses...)
+	}
+	for propertyId, value := range results {
+		vars.Set("result."+propertyId, value)
+	}
+	return vars
+}
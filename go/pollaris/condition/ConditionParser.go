@@ -0,0 +1,451 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package condition
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// tokenKind is the kind of a condition token.
+type tokenKind int
+
+const (
+	// tokenEnd marks the end of the condition
+	tokenEnd tokenKind = iota
+	// tokenName is a value name or a keyword
+	tokenName
+	// tokenString is a quoted string literal
+	tokenString
+	// tokenNumber is a number literal
+	tokenNumber
+	// tokenSymbol is an operator, a parenthesis or a comma
+	tokenSymbol
+)
+
+// token is a lexical element of a condition.
+type token struct {
+	// kind is the token kind
+	kind tokenKind
+	// text is the token text, unquoted for strings
+	text string
+	// pos is the offset of the token in the condition
+	pos int
+}
+
+// symbols are the operators and punctuation, longest first.
+var symbols = []string{"==", "!=", "<=", ">=", "=", "<", ">", "(", ")", ","}
+
+// tokenize splits a condition into tokens.
+func tokenize(text string) ([]token, error) {
+	tokens := make([]token, 0)
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(text[i+1:], c)
+			if end < 0 {
+				return nil, errors.New("unterminated string at " + strconv.Itoa(i))
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text[i+1 : i+1+end], pos: i})
+			i += end + 2
+		case isDigit(c) || (c == '-' && i+1 < len(text) && isDigit(text[i+1])):
+			start := i
+			i++
+			for i < len(text) && (isDigit(text[i]) || text[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text[start:i], pos: start})
+		case isNameStart(c):
+			start := i
+			for i < len(text) && (isNameStart(text[i]) || isDigit(text[i]) || text[i] == '.' || text[i] == '-') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenName, text: text[start:i], pos: start})
+		default:
+			symbol := ""
+			for _, s := range symbols {
+				if strings.HasPrefix(text[i:], s) {
+					symbol = s
+					break
+				}
+			}
+			if symbol == "" {
+				return nil, errors.New("unexpected character '" + string(c) + "' at " + strconv.Itoa(i))
+			}
+			tokens = append(tokens, token{kind: tokenSymbol, text: symbol, pos: i})
+			i += len(symbol)
+		}
+	}
+	return append(tokens, token{kind: tokenEnd, pos: len(text)}), nil
+}
+
+// isDigit reports whether c is a decimal digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isNameStart reports whether c can start a name.
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// parser is a recursive descent parser of conditions.
+type parser struct {
+	// tokens are the condition tokens
+	tokens []token
+	// pos is the index of the next token
+	pos int
+}
+
+// Parse parses a condition. Returns an error locating the first problem.
+func Parse(text string) (Expression, error) {
+	tokens, err := tokenize(text)
+	if err != nil {
+		return nil, errors.New("condition: " + err.Error())
+	}
+	p := &parser{tokens: tokens}
+	expression, err := p.or()
+	if err == nil && p.peek().kind != tokenEnd {
+		err = p.unexpected()
+	}
+	if err != nil {
+		return nil, errors.New("condition: " + err.Error())
+	}
+	return expression, nil
+}
+
+// peek returns the next token.
+func (this *parser) peek() token {
+	return this.tokens[this.pos]
+}
+
+// next consumes and returns the next token.
+func (this *parser) next() token {
+	t := this.tokens[this.pos]
+	if t.kind != tokenEnd {
+		this.pos++
+	}
+	return t
+}
+
+// keyword consumes the next token if it is the keyword, ignoring case.
+func (this *parser) keyword(word string) bool {
+	t := this.peek()
+	if t.kind == tokenName && strings.EqualFold(t.text, word) {
+		this.pos++
+		return true
+	}
+	return false
+}
+
+// symbol consumes the next token if it is the symbol.
+func (this *parser) symbol(s string) bool {
+	t := this.peek()
+	if t.kind == tokenSymbol && t.text == s {
+		this.pos++
+		return true
+	}
+	return false
+}
+
+// unexpected returns the error of an unexpected next token.
+func (this *parser) unexpected() error {
+	t := this.peek()
+	if t.kind == tokenEnd {
+		return errors.New("unexpected end at " + strconv.Itoa(t.pos))
+	}
+	return errors.New("unexpected '" + t.text + "' at " + strconv.Itoa(t.pos))
+}
+
+// or parses: and ("or" and)*
+func (this *parser) or() (Expression, error) {
+	left, err := this.and()
+	if err != nil {
+		return nil, err
+	}
+	for this.keyword("or") {
+		right, err := this.and()
+		if err != nil {
+			return nil, err
+		}
+		left = &orExpression{left: left, right: right}
+	}
+	return left, nil
+}
+
+// and parses: not ("and" not)*
+func (this *parser) and() (Expression, error) {
+	left, err := this.not()
+	if err != nil {
+		return nil, err
+	}
+	for this.keyword("and") {
+		right, err := this.not()
+		if err != nil {
+			return nil, err
+		}
+		left = &andExpression{left: left, right: right}
+	}
+	return left, nil
+}
+
+// not parses: "not" not | "(" or ")" | comparison
+func (this *parser) not() (Expression, error) {
+	if this.keyword("not") {
+		inner, err := this.not()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpression{inner: inner}, nil
+	}
+	if this.symbol("(") {
+		inner, err := this.or()
+		if err != nil {
+			return nil, err
+		}
+		if !this.symbol(")") {
+			return nil, this.unexpected()
+		}
+		return inner, nil
+	}
+	return this.comparison()
+}
+
+// comparison parses: operand [operator operand | "in" "(" operand ("," operand)* ")"]
+func (this *parser) comparison() (Expression, error) {
+	left, err := this.operand()
+	if err != nil {
+		return nil, err
+	}
+	t := this.peek()
+	switch {
+	case t.kind == tokenSymbol && t.text != "(" && t.text != ")" && t.text != ",":
+		this.next()
+		right, err := this.operand()
+		if err != nil {
+			return nil, err
+		}
+		op := t.text
+		if op == "==" {
+			op = "="
+		}
+		return &compareExpression{left: left, op: op, right: []*operand{right}}, nil
+	case this.keyword("contains"):
+		right, err := this.operand()
+		if err != nil {
+			return nil, err
+		}
+		return &compareExpression{left: left, op: "contains", right: []*operand{right}}, nil
+	case this.keyword("matches"):
+		at := this.pos
+		pattern := this.next()
+		if pattern.kind != tokenString {
+			this.pos = at
+			return nil, this.unexpected()
+		}
+		re, err := regexp.Compile(pattern.text)
+		if err != nil {
+			return nil, errors.New("bad pattern at " + strconv.Itoa(pattern.pos) + ": " + err.Error())
+		}
+		return &matchExpression{left: left, re: re}, nil
+	case this.keyword("in"):
+		if !this.symbol("(") {
+			return nil, this.unexpected()
+		}
+		list := make([]*operand, 0)
+		for {
+			item, err := this.operand()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+			if this.symbol(")") {
+				break
+			}
+			if !this.symbol(",") {
+				return nil, this.unexpected()
+			}
+		}
+		return &compareExpression{left: left, op: "=", right: list}, nil
+	}
+	return &existsExpression{operand: left}, nil
+}
+
+// operand parses a value name or a literal.
+func (this *parser) operand() (*operand, error) {
+	t := this.peek()
+	switch t.kind {
+	case tokenName:
+		if isKeyword(t.text) {
+			return nil, this.unexpected()
+		}
+		this.next()
+		return &operand{name: t.text}, nil
+	case tokenString, tokenNumber:
+		this.next()
+		return &operand{literal: t.text, isLiteral: true}, nil
+	}
+	return nil, this.unexpected()
+}
+
+// isKeyword reports whether the name is a reserved keyword.
+func isKeyword(name string) bool {
+	switch strings.ToLower(name) {
+	case "and", "or", "not", "contains", "matches", "in":
+		return true
+	}
+	return false
+}
+
+// operand is a value name or a literal of a comparison.
+type operand struct {
+	// name is the value name, when not a literal
+	name string
+	// literal is the literal value
+	literal string
+	// isLiteral tells whether the operand is a literal
+	isLiteral bool
+}
+
+// values returns the values of the operand in the context.
+func (this *operand) values(ctx Context) []string {
+	if this.isLiteral {
+		return []string{this.literal}
+	}
+	if ctx == nil {
+		return nil
+	}
+	return ctx.Lookup(this.name)
+}
+
+// orExpression holds when either side holds.
+type orExpression struct {
+	left, right Expression
+}
+
+// Eval evaluates the expression.
+func (this *orExpression) Eval(ctx Context) bool {
+	return this.left.Eval(ctx) || this.right.Eval(ctx)
+}
+
+// andExpression holds when both sides hold.
+type andExpression struct {
+	left, right Expression
+}
+
+// Eval evaluates the expression.
+func (this *andExpression) Eval(ctx Context) bool {
+	return this.left.Eval(ctx) && this.right.Eval(ctx)
+}
+
+// notExpression holds when its inner expression does not.
+type notExpression struct {
+	inner Expression
+}
+
+// Eval evaluates the expression.
+func (this *notExpression) Eval(ctx Context) bool {
+	return !this.inner.Eval(ctx)
+}
+
+// existsExpression holds when its operand has a non-empty value.
+type existsExpression struct {
+	operand *operand
+}
+
+// Eval evaluates the expression.
+func (this *existsExpression) Eval(ctx Context) bool {
+	for _, value := range this.operand.values(ctx) {
+		if value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// matchExpression holds when a value of its operand matches the pattern.
+type matchExpression struct {
+	left *operand
+	re   *regexp.Regexp
+}
+
+// Eval evaluates the expression.
+func (this *matchExpression) Eval(ctx Context) bool {
+	for _, value := range this.left.values(ctx) {
+		if this.re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareExpression holds when the operator holds for a value of the left
+// operand and a value of any of the right operands; "!=" holds when "=" does not.
+type compareExpression struct {
+	left  *operand
+	op    string
+	right []*operand
+}
+
+// Eval evaluates the expression.
+func (this *compareExpression) Eval(ctx Context) bool {
+	op := this.op
+	if op == "!=" {
+		op = "="
+	}
+	found := false
+	for _, right := range this.right {
+		for _, b := range right.values(ctx) {
+			for _, a := range this.left.values(ctx) {
+				if compare(a, op, b) {
+					found = true
+				}
+			}
+		}
+	}
+	if this.op == "!=" {
+		return !found
+	}
+	return found
+}
+
+// compare applies the operator to two values.
+func compare(a, op, b string) bool {
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+	numeric := errX == nil && errY == nil
+	switch op {
+	case "=":
+		if numeric {
+			return x == y
+		}
+		return strings.EqualFold(a, b)
+	case "contains":
+		return strings.Contains(strings.ToLower(a), strings.ToLower(b))
+	case "<":
+		return numeric && x < y
+	case "<=":
+		return numeric && x <= y
+	case ">":
+		return numeric && x > y
+	case ">=":
+		return numeric && x >= y
+	}
+	return false
+}
@@ -17,11 +17,13 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/saichler/l8bus/go/overlay/health"
+	"github.com/saichler/l8pollaris/go/pollaris/audit"
 	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
 	"github.com/saichler/l8ql/go/gsql/interpreter"
 	"github.com/saichler/l8srlz/go/serialize/object"
 	"github.com/saichler/l8types/go/ifs"
 	"github.com/saichler/l8utils/go/utils/strings"
+	"google.golang.org/protobuf/proto"
 	"strconv"
 	"time"
 )
@@ -33,8 +35,10 @@ import (
 // 2. Updates target states in the database in batches of 500
 // 3. For UP state: uses round-robin to distribute targets across collectors
 // 4. For DOWN state: multicasts to all collectors to stop the target
+// The state changes are added to the audit trail when auditing is enabled,
+// with the actor that requested them.
 // A small delay (10 microseconds) is added between sends to prevent overwhelming collectors.
-func (this *TargetCallback) startStopAll(state l8tpollaris.L8PTargetState, typ l8tpollaris.L8PTargetType, vnic ifs.IVNic) {
+func (this *TargetCallback) startStopAll(state l8tpollaris.L8PTargetState, typ l8tpollaris.L8PTargetType, actor string, vnic ifs.IVNic) {
 	leader := vnic.Resources().Services().GetLeader(ServiceName, ServiceArea)
 	if leader != vnic.Resources().SysConfig().LocalUuid {
 		return
@@ -59,6 +63,7 @@ func (this *TargetCallback) startStopAll(state l8tpollaris.L8PTargetState, typ l
 	collectorArea := byte(0)
 	page := 0
 	targets := make([]*l8tpollaris.L8PTarget, 0)
+	before := make(map[string]*l8tpollaris.L8PTarget)
 	for {
 		buff := bytes.Buffer{}
 		buff.Write(gsql.Bytes())
@@ -83,6 +88,9 @@ func (this *TargetCallback) startStopAll(state l8tpollaris.L8PTargetState, typ l
 		fmt.Println("Size of elements=", len(resp.Elements()))
 		for _, elem := range resp.Elements() {
 			item := elem.(*l8tpollaris.L8PTarget)
+			if audit.Enabled() {
+				before[item.TargetId] = proto.Clone(item).(*l8tpollaris.L8PTarget)
+			}
 			item.State = state
 			targets = append(targets, item)
 			if collectorService == "" {
@@ -102,6 +110,8 @@ func (this *TargetCallback) startStopAll(state l8tpollaris.L8PTargetState, typ l
 			err := this.iorm.Write(ifs.PATCH, elems, vnic.Resources())
 			if err != nil {
 				vnic.Resources().Logger().Error(err.Error())
+			} else {
+				recordStartStop(bulk, before, actor, vnic)
 			}
 			bulk = make([]*l8tpollaris.L8PTarget, 0)
 		}
@@ -112,6 +122,8 @@ func (this *TargetCallback) startStopAll(state l8tpollaris.L8PTargetState, typ l
 		err := this.iorm.Write(ifs.PATCH, elems, vnic.Resources())
 		if err != nil {
 			vnic.Resources().Logger().Error(err.Error())
+		} else {
+			recordStartStop(bulk, before, actor, vnic)
 		}
 	}
 
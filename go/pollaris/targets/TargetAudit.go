@@ -0,0 +1,103 @@
+// © 2025 Sharon Aicler (saichler@gmail.com)
+//
+// Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targets
+
+import (
+	"github.com/saichler/l8pollaris/go/pollaris"
+	"github.com/saichler/l8pollaris/go/pollaris/audit"
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/proto"
+)
+
+// auditObjectType is the object type of the target audit records.
+const auditObjectType = "L8PTarget"
+
+// auditOperations names the audited target operations.
+var auditOperations = map[ifs.Action]string{
+	ifs.POST:   "POST",
+	ifs.PUT:    "PUT",
+	ifs.PATCH:  "PATCH",
+	ifs.DELETE: "DELETE",
+}
+
+// audited runs the operation on the targets of the request and, when
+// auditing is enabled and the operation succeeds, adds the change of each
+// target to the audit trail, with the diff from the stored target before
+// the change to after it. Changes received as notifications are recorded by
+// the instance where they originated.
+func (this *TargetService) audited(action ifs.Action, pb ifs.IElements, vnic ifs.IVNic,
+	operation func(ifs.IElements, ifs.IVNic) ifs.IElements) ifs.IElements {
+	if !audit.Enabled() || pb.Notification() {
+		return operation(pb, vnic)
+	}
+	targets := targetsOf(pb)
+	before := make(map[string]*l8tpollaris.L8PTarget)
+	for _, target := range targets {
+		current, err := Target(target.TargetId, vnic)
+		if err == nil && current != nil {
+			before[target.TargetId] = proto.Clone(current).(*l8tpollaris.L8PTarget)
+		}
+	}
+	resp := operation(pb, vnic)
+	if resp.Error() != nil {
+		return resp
+	}
+	actor := audit.ActorOf(pollaris.IdentityOf(pb))
+	name := auditOperations[action]
+	for _, target := range targets {
+		var after *l8tpollaris.L8PTarget
+		switch action {
+		case ifs.POST:
+			after = target
+		case ifs.PUT, ifs.PATCH:
+			stored, err := Target(target.TargetId, vnic)
+			if err != nil {
+				vnic.Resources().Logger().Error("Cannot audit ", name, " of target ", target.TargetId, ": ", err.Error())
+				continue
+			}
+			after = stored
+		}
+		err := audit.Record(actor, name, auditObjectType, target.TargetId, before[target.TargetId], after)
+		if err != nil {
+			vnic.Resources().Logger().Error("Cannot audit ", name, " of target ", target.TargetId, ": ", err.Error())
+		}
+	}
+	return resp
+}
+
+// targetsOf returns the targets of a request, a single target or a list of them.
+func targetsOf(pb ifs.IElements) []*l8tpollaris.L8PTarget {
+	targets := make([]*l8tpollaris.L8PTarget, 0)
+	for _, elem := range pb.Elements() {
+		switch item := elem.(type) {
+		case *l8tpollaris.L8PTarget:
+			targets = append(targets, item)
+		case *l8tpollaris.L8PTargetList:
+			targets = append(targets, item.List...)
+		}
+	}
+	return targets
+}
+
+// recordStartStop adds the state changes of a bulk start or stop to the audit
+// trail, with the copies of the targets before the change.
+func recordStartStop(targets []*l8tpollaris.L8PTarget, before map[string]*l8tpollaris.L8PTarget, actor string, vnic ifs.IVNic) {
+	for _, target := range targets {
+		err := audit.Record(actor, "PATCH", auditObjectType, target.TargetId, before[target.TargetId], target)
+		if err != nil {
+			vnic.Resources().Logger().Error("Cannot audit state of target ", target.TargetId, ": ", err.Error())
+		}
+	}
+}
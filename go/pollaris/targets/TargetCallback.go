@@ -15,7 +15,6 @@ package targets
 
 import (
 	"errors"
-	"fmt"
 	"github.com/saichler/l8orm/go/orm/common"
 	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
 	"github.com/saichler/l8types/go/ifs"
@@ -41,12 +40,13 @@ func newTargetCallback(iorm common.IORM) *TargetCallback {
 
 // Before is called before a target operation is persisted.
 // For POST operations:
-//   - Handles TargetAction requests to start/stop all targets of a type
 //   - Validates IP addresses for L8PTargetList and L8PTarget to prevent duplicates
 //
 // For PATCH operations:
 //   - Verifies the target exists before allowing updates
 //
+// TargetAction requests are handled by TargetService before reaching the ORM.
+//
 // Returns (elements, continue, error) where:
 //   - elements: modified element(s) to process
 //   - continue: whether to proceed with the operation
@@ -55,12 +55,6 @@ func (this *TargetCallback) Before(elem interface{}, action ifs.Action, notifica
 	switch action {
 	case ifs.POST:
 		if !notification {
-			targetAction, ok := elem.(*l8tpollaris.TargetAction)
-			if ok {
-				fmt.Println("Performing Action:", targetAction)
-				this.startStopAll(targetAction.ActionState, targetAction.ActionType, vnic)
-				return nil, false, nil
-			}
 			list, ok := elem.(*l8tpollaris.L8PTargetList)
 			if ok {
 				elems := make([]interface{}, 0)
@@ -93,7 +87,6 @@ func (this *TargetCallback) Before(elem interface{}, action ifs.Action, notifica
 			}
 		}
 	}
-
 	return nil, true, nil
 }
 
@@ -20,6 +20,8 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/saichler/l8orm/go/orm/persist"
 	"github.com/saichler/l8orm/go/orm/plugins/postgres"
+	"github.com/saichler/l8pollaris/go/pollaris"
+	"github.com/saichler/l8pollaris/go/pollaris/audit"
 	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
 	"github.com/saichler/l8srlz/go/serialize/object"
 	"github.com/saichler/l8types/go/ifs"
@@ -41,6 +43,51 @@ const (
 // This must be set by the application before calling Activate.
 var Links TargetLinks
 
+// TargetService is the handler of the Targets service: the ORM service, with
+// the bulk start and stop actions and the audit trail of the target changes.
+// The callbacks of the ORM service do not carry the caller of the request,
+// so the changes are audited around the ORM service calls, with the caller
+// identity as the actor.
+type TargetService struct {
+	ifs.IServiceHandler
+	// callback is the lifecycle callback of the ORM service
+	callback *TargetCallback
+}
+
+// Post starts or stops all the targets of a type for a TargetAction, or adds
+// the targets of the request.
+func (this *TargetService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	targetAction, ok := pb.Element().(*l8tpollaris.TargetAction)
+	if ok && !pb.Notification() {
+		vnic.Resources().Logger().Info("Performing Action: ", targetAction)
+		this.callback.startStopAll(targetAction.ActionState, targetAction.ActionType,
+			audit.ActorOf(pollaris.IdentityOf(pb)), vnic)
+		return object.New(nil, &l8web.L8Empty{})
+	}
+	return this.audited(ifs.POST, pb, vnic, this.IServiceHandler.Post)
+}
+
+// Handle is called by the service framework with the incoming message, so
+// the sender of the message is audited as the actor, see pollaris.Dispatch.
+func (this *TargetService) Handle(pb ifs.IElements, msg *ifs.Message, vnic ifs.IVNic) ifs.IElements {
+	return pollaris.Dispatch(this, pb, msg, vnic)
+}
+
+// Put replaces the targets of the request.
+func (this *TargetService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.audited(ifs.PUT, pb, vnic, this.IServiceHandler.Put)
+}
+
+// Patch updates the targets of the request.
+func (this *TargetService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.audited(ifs.PATCH, pb, vnic, this.IServiceHandler.Patch)
+}
+
+// Delete removes the targets of the request.
+func (this *TargetService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.audited(ifs.DELETE, pb, vnic, this.IServiceHandler.Delete)
+}
+
 // Activate initializes and registers the Targets service with the VNic.
 // It establishes a PostgreSQL database connection, creates the ORM service,
 // sets up lifecycle callbacks, and configures web service endpoints.
@@ -60,7 +107,8 @@ func Activate(creds, dbname string, vnic ifs.IVNic) {
 
 	callback := newTargetCallback(p)
 
-	sla := ifs.NewServiceLevelAgreement(&persist.OrmService{}, ServiceName, ServiceArea, true, callback)
+	handler := &TargetService{IServiceHandler: &persist.OrmService{}, callback: callback}
+	sla := ifs.NewServiceLevelAgreement(handler, ServiceName, ServiceArea, true, callback)
 	sla.SetServiceItem(&l8tpollaris.L8PTarget{})
 	sla.SetServiceItemList(&l8tpollaris.L8PTargetList{})
 	sla.SetPrimaryKeys("TargetId")
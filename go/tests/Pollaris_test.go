@@ -17,12 +17,26 @@
 package tests
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/saichler/l8collector/go/collector/common"
 	"github.com/saichler/l8parser/go/parser/boot"
 	"github.com/saichler/l8pollaris/go/pollaris"
+	"github.com/saichler/l8pollaris/go/pollaris/audit"
+	"github.com/saichler/l8pollaris/go/pollaris/condition"
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8ql/go/gsql/interpreter"
+	"github.com/saichler/l8srlz/go/serialize/object"
 	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8types/go/types/l8api"
+	"google.golang.org/protobuf/proto"
 )
 
 // TestMain is the test entry point that sets up and tears down the test topology.
@@ -66,3 +80,2167 @@ func TestPollaris(t *testing.T) {
 		return
 	}
 }
+
+// activatePollaris returns the PollarisCenter of the given vnic, activating
+// the PollarisService first if it is not already running.
+func activatePollaris(vnic ifs.IVNic) *pollaris.PollarisCenter {
+	p := pollaris.Pollaris(vnic.Resources())
+	if p != nil {
+		return p
+	}
+	vnic.Resources().Registry().Register(pollaris.PollarisService{})
+	sla := ifs.NewServiceLevelAgreement(&pollaris.PollarisService{}, pollaris.ServiceName, 0, true, nil)
+	vnic.Resources().Services().Activate(sla, vnic)
+	return pollaris.Pollaris(vnic.Resources())
+}
+
+// TestPollarisGet verifies retrieval of pollarises through the service Get:
+// 1. Posts the boot01 configuration
+// 2. Gets it by name using a L8Pollaris filter
+// 3. Queries it by group using a GSQL query on the PollarisCenter
+// 4. Queries it through the service and pages the result
+func TestPollarisGet(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	pollrs := boot.CreateBoot01()
+	err := p.Post(pollrs, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	handler, _ := vnic.Resources().Services().ServiceHandler(pollaris.ServiceName, 0)
+	resp := handler.Get(object.New(nil, &l8tpollaris.L8Pollaris{Name: pollrs.Name}), vnic)
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	byName, ok := resp.Element().(*l8tpollaris.L8Pollaris)
+	if !ok || byName.Name != pollrs.Name {
+		vnic.Resources().Logger().Fail(t, "Expected pollaris ", pollrs.Name)
+		return
+	}
+
+	q, err := interpreter.NewQuery("select * from L8Pollaris where groups="+common.BOOT_STAGE_01, vnic.Resources())
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	list := p.Query(q)
+	if len(list) == 0 {
+		vnic.Resources().Logger().Fail(t, "Expected pollarises in group ", common.BOOT_STAGE_01)
+		return
+	}
+
+	for _, name := range []string{"page-a", "page-b", "page-c"} {
+		paged := boot.CreateBoot01()
+		paged.Name = name
+		paged.Groups = []string{"paged-group"}
+		err = p.Post(paged, false)
+		if err != nil {
+			vnic.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+	resp = handler.Get(object.New(nil, &l8api.L8Query{Text: "select * from L8Pollaris where groups=paged-group"}), vnic)
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	all, ok := resp.Element().(*l8tpollaris.L8PollarisList)
+	if !ok || len(all.List) != 3 || all.Metadata.KeyCount["Total"] != 3 {
+		vnic.Resources().Logger().Fail(t, "Expected the 3 pollarises of paged-group with a total of 3")
+		return
+	}
+	resp = handler.Get(object.New(nil, &l8api.L8Query{Text: "select * from L8Pollaris where groups=paged-group limit 2 page 1"}), vnic)
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	page, ok := resp.Element().(*l8tpollaris.L8PollarisList)
+	if !ok || len(page.List) != 1 || page.Metadata.KeyCount["Total"] != 3 {
+		vnic.Resources().Logger().Fail(t, "Expected 1 pollaris on the second page with a total of 3")
+		return
+	}
+}
+
+// TestPollarisDelete verifies that deleting a pollaris removes it from the
+// cache as well as from the key and group indexes.
+func TestPollarisDelete(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	pollrs := boot.CreateBoot01()
+	pollrs.Name = "delete-me"
+	pollrs.Groups = []string{"delete-group"}
+	err := p.Post(pollrs, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	err = p.Delete(&l8tpollaris.L8Pollaris{Name: pollrs.Name}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	if p.PollarisByName(pollrs.Name) != nil {
+		vnic.Resources().Logger().Fail(t, "Expected pollaris to be deleted")
+		return
+	}
+	if p.PollarisByKey(pollrs.Name) != nil {
+		vnic.Resources().Logger().Fail(t, "Expected key index to be cleaned")
+		return
+	}
+	if len(p.Names("delete-group", "", "", "", "", "", "")) != 0 {
+		vnic.Resources().Logger().Fail(t, "Expected group index to be cleaned")
+		return
+	}
+	if p.Delete(&l8tpollaris.L8Pollaris{Name: pollrs.Name}, false) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected error deleting a missing pollaris")
+		return
+	}
+}
+
+// TestPollarisPatch verifies that a partial pollaris is merged into the
+// existing one, that the group index follows the patched groups and that a
+// patch stored while another one is merged is not overwritten.
+func TestPollarisPatch(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	pollrs := boot.CreateBoot01()
+	pollrs.Name = "patch-me"
+	pollrs.Groups = []string{"patch-group"}
+	err := p.Post(pollrs, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	pollName := ""
+	for name := range pollrs.Polling {
+		pollName = name
+		break
+	}
+	patch := &l8tpollaris.L8Pollaris{Name: pollrs.Name}
+	patch.Groups = []string{"patched-group"}
+	patch.RemoveGroups = []string{"patch-group"}
+	patch.Polling = map[string]*l8tpollaris.L8Poll{
+		pollName: {Cadence: &l8tpollaris.L8PCadencePlan{Cadences: []int64{42}, Enabled: true}},
+	}
+	err = p.Patch(patch, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	poll := p.Poll(pollrs.Name, pollName)
+	if poll == nil || poll.What != pollrs.Polling[pollName].What || poll.Cadence.Cadences[0] != 42 {
+		vnic.Resources().Logger().Fail(t, "Expected poll cadence to be patched")
+		return
+	}
+	if len(p.Names("patch-group", "", "", "", "", "", "")) != 0 {
+		vnic.Resources().Logger().Fail(t, "Expected pollaris to be removed from patch-group")
+		return
+	}
+	if len(p.Names("patched-group", "", "", "", "", "", "")) != 1 {
+		vnic.Resources().Logger().Fail(t, "Expected pollaris to be added to patched-group")
+		return
+	}
+
+	// a patch stored while another one is being merged is not overwritten
+	interleaved := false
+	pollaris.RegisterValidator("interleave", pollaris.ValidatorFunc(
+		func(l8pollaris *l8tpollaris.L8Pollaris, resources ifs.IResources) pollaris.ValidationErrors {
+			if l8pollaris.Name == pollrs.Name && contains(l8pollaris.Groups, "first-group") && !interleaved {
+				interleaved = true
+				err := p.Patch(&l8tpollaris.L8Pollaris{Name: pollrs.Name, Groups: []string{"second-group"}}, false)
+				if err != nil {
+					vnic.Resources().Logger().Fail(t, err.Error())
+				}
+			}
+			return nil
+		}))
+	defer pollaris.UnregisterValidator("interleave")
+	err = p.Patch(&l8tpollaris.L8Pollaris{Name: pollrs.Name, Groups: []string{"first-group"}}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	groups := p.Definition(pollrs.Name).Groups
+	if !interleaved || !contains(groups, "first-group") || !contains(groups, "second-group") {
+		vnic.Resources().Logger().Fail(t, "Expected both interleaved patches to be applied")
+		return
+	}
+}
+
+// TestPollarisRollback verifies that updates create new revisions and that
+// rolling back restores an older revision under a new revision number.
+func TestPollarisRollback(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	pollrs := boot.CreateBoot01()
+	pollrs.Name = "rollback-me"
+	err := p.Post(pollrs, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	original := pollrs.Revision
+
+	pollName := sortedKeys(pollrs.Polling)[0]
+	originalCadences := pollrs.Polling[pollName].Cadence.Cadences
+	err = p.Patch(&l8tpollaris.L8Pollaris{Name: pollrs.Name, Polling: map[string]*l8tpollaris.L8Poll{
+		pollName: {Cadence: &l8tpollaris.L8PCadencePlan{Cadences: []int64{42}, Enabled: true}}}}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	err = p.Rollback(pollrs.Name, original, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	restored := p.PollarisByName(pollrs.Name)
+	if restored.Revision != original+2 {
+		vnic.Resources().Logger().Fail(t, "Expected revision ", original+2, " but got ", restored.Revision)
+		return
+	}
+	if restored.Polling[pollName].Cadence.Cadences[0] != originalCadences[0] {
+		vnic.Resources().Logger().Fail(t, "Expected poll to be rolled back")
+		return
+	}
+	if len(p.Revisions(pollrs.Name)) != 3 {
+		vnic.Resources().Logger().Fail(t, "Expected 3 revisions")
+		return
+	}
+}
+
+// TestPollarisInheritance verifies that a pollaris extending a base one
+// resolves to the merged polls, that inheritance cycles are rejected and
+// that an extended pollaris cannot be deleted before the ones extending it.
+func TestPollarisInheritance(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	base := boot.CreateBoot01()
+	base.Name = "inherit-base"
+	err := p.Post(base, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	excluded := ""
+	for name := range base.Polling {
+		excluded = name
+		break
+	}
+	child := &l8tpollaris.L8Pollaris{Name: "inherit-child", Vendor: "cisco", Extends: base.Name}
+	child.Excludes = []string{excluded}
+	child.Polling = map[string]*l8tpollaris.L8Poll{
+		"extra": {Name: "extra", What: ".1.3.6.1.2.1.1.5.0", Operation: l8tpollaris.L8C_Operation_L8C_Get,
+			Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2},
+	}
+	err = p.Post(child, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	effective := p.PollarisByName(child.Name)
+	if effective == nil || len(effective.Polling) != len(base.Polling) {
+		vnic.Resources().Logger().Fail(t, "Expected inherited polls")
+		return
+	}
+	if effective.Polling["extra"] == nil || effective.Polling[excluded] != nil {
+		vnic.Resources().Logger().Fail(t, "Expected added poll and excluded poll to be applied")
+		return
+	}
+	if p.Definition(child.Name).Polling[excluded] != nil {
+		vnic.Resources().Logger().Fail(t, "Expected definition to stay unresolved")
+		return
+	}
+
+	cyclic := proto.Clone(base).(*l8tpollaris.L8Pollaris)
+	cyclic.Extends = child.Name
+	if p.Put(cyclic, false) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected inheritance cycle to be rejected")
+		return
+	}
+
+	if p.Delete(&l8tpollaris.L8Pollaris{Name: base.Name}, false) == nil || p.Definition(base.Name) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected delete of an extended pollaris to be rejected")
+		return
+	}
+	err = p.Delete(&l8tpollaris.L8Pollaris{Name: child.Name}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	err = p.Delete(&l8tpollaris.L8Pollaris{Name: base.Name}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	// a query matching a whole chain removes the derived pollarises first
+	chainBase := boot.CreateBoot01()
+	chainBase.Name = "chain-base"
+	chainBase.Groups = []string{"chain-group"}
+	chainChild := &l8tpollaris.L8Pollaris{Name: "chain-child", Extends: chainBase.Name, Groups: []string{"chain-group"}}
+	for _, l8pollaris := range []*l8tpollaris.L8Pollaris{chainBase, chainChild} {
+		err = p.Post(l8pollaris, false)
+		if err != nil {
+			vnic.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+	q, err := interpreter.NewQuery("select * from L8Pollaris where groups=chain-group", vnic.Resources())
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	deleted, err := p.DeleteByQuery(q, false)
+	if err != nil || len(deleted) != 2 || deleted[0] != chainChild.Name {
+		vnic.Resources().Logger().Fail(t, "Expected the chain to be deleted child first")
+		return
+	}
+}
+
+// TestPollarisGroupMatching verifies that group resolution picks the most
+// specific variant of each logical pollaris and excludes members that
+// contradict the device attributes.
+func TestPollarisGroupMatching(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	group := []string{"match-group"}
+	generic := boot.CreateBoot01()
+	generic.Name = "match-generic"
+	generic.Groups = group
+	posts := []*l8tpollaris.L8Pollaris{generic,
+		{Name: "match-cisco", Vendor: "cisco", Extends: generic.Name, Groups: group},
+		{Name: "match-juniper", Vendor: "juniper", Extends: generic.Name, Groups: group},
+		{Name: "match-juniper-only", Vendor: "juniper", Groups: group, Polling: generic.Polling},
+	}
+	for _, pollrs := range posts {
+		err := p.Post(pollrs, false)
+		if err != nil {
+			vnic.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+
+	expect := map[string][]string{
+		"cisco":   {"match-cisco"},
+		"juniper": {"match-juniper", "match-juniper-only"},
+		"arista":  {"match-generic"},
+		"":        {"match-generic", "match-juniper-only"},
+	}
+	for vendor, expected := range expect {
+		names := p.Names(group[0], vendor, "", "", "", "", "")
+		if len(names) != len(expected) {
+			vnic.Resources().Logger().Fail(t, "Vendor ", vendor, ": expected ", expected, " but got ", names)
+			return
+		}
+		for i, name := range names {
+			if name != expected[i] {
+				vnic.Resources().Logger().Fail(t, "Vendor ", vendor, ": expected ", expected, " but got ", names)
+				return
+			}
+		}
+	}
+}
+
+// TestPollarisKeyPatterns verifies that PollarisByKey matches version range
+// and glob patterns, and prefers the narrowest match: an exact value over the
+// narrowest range over a wildcard.
+func TestPollarisKeyPatterns(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	base := boot.CreateBoot01()
+	base.Name = "pattern-base"
+	posts := []*l8tpollaris.L8Pollaris{base,
+		{Name: "pattern-17", Vendor: "cisco", Version: "17.x", Extends: base.Name},
+		{Name: "pattern-17-3", Vendor: "cisco", Version: ">=17.3 <18", Extends: base.Name},
+		{Name: "pattern-17-3-1", Vendor: "cisco", Version: "17.3.1", Extends: base.Name},
+	}
+	for _, pollrs := range posts {
+		err := p.Post(pollrs, false)
+		if err != nil {
+			vnic.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+
+	expect := map[string]string{
+		"17.3.1": "pattern-17-3-1",
+		"17.4":   "pattern-17-3",
+		"17.2":   "pattern-17",
+		"16.1":   "pattern-base",
+	}
+	for version, expected := range expect {
+		found := p.PollarisByKey(base.Name, "cisco", "", "", "", "", version)
+		if found == nil || found.Name != expected {
+			vnic.Resources().Logger().Fail(t, "Version ", version, ": expected ", expected)
+			return
+		}
+	}
+
+	// ties on the matched attributes are broken by the narrowest match,
+	// not by the name, comparing build numbers of any size
+	tie := boot.CreateBoot01()
+	tie.Name = "tie-base"
+	posts = []*l8tpollaris.L8Pollaris{tie,
+		{Name: "tie-a-wide", Vendor: "cisco", Version: ">=17 <19", Extends: tie.Name},
+		{Name: "tie-b-narrow", Vendor: "cisco", Version: "~17.3", Extends: tie.Name},
+		{Name: "tie-a-any", Vendor: "cisco", Series: "cat*", Extends: tie.Name},
+		{Name: "tie-b-catalyst", Vendor: "cisco", Series: "catalyst-9*", Extends: tie.Name},
+		{Name: "tie-build-high", Vendor: "cisco", Version: ">=17.3.1990 <17.4", Extends: tie.Name},
+		{Name: "tie-build-low", Vendor: "cisco", Version: ">=17.3.5 <17.3.2000", Extends: tie.Name},
+	}
+	for _, pollrs := range posts {
+		err := p.Post(pollrs, false)
+		if err != nil {
+			vnic.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+	ties := []struct {
+		series, version, expected string
+	}{
+		{"", "17.3.2", "tie-b-narrow"},
+		{"", "18.1", "tie-a-wide"},
+		{"", "17.3.1995", "tie-build-low"},
+		{"catalyst-9300", "", "tie-b-catalyst"},
+		{"cat-6500", "", "tie-a-any"},
+	}
+	for _, tc := range ties {
+		found := p.PollarisByKey(tie.Name, "cisco", tc.series, "", "", "", tc.version)
+		if found == nil || found.Name != tc.expected {
+			vnic.Resources().Logger().Fail(t, "Series ", tc.series, " version ", tc.version, ": expected ", tc.expected)
+			return
+		}
+	}
+
+	bad := &l8tpollaris.L8Pollaris{Name: "pattern-bad", Version: ">=abc", Extends: base.Name}
+	if p.Post(bad, false) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected invalid version range to be rejected")
+		return
+	}
+}
+
+// TestPollarisExplain verifies that the explain trace reports the keys
+// tried, the skipped candidates and the chosen pollaris.
+func TestPollarisExplain(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	base := boot.CreateBoot01()
+	base.Name = "explain-base"
+	posts := []*l8tpollaris.L8Pollaris{base,
+		{Name: "explain-cisco", Vendor: "cisco", Extends: base.Name},
+		{Name: "explain-juniper", Vendor: "juniper", Extends: base.Name},
+	}
+	for _, pollrs := range posts {
+		err := p.Post(pollrs, false)
+		if err != nil {
+			vnic.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+
+	explain := p.Explain(&l8tpollaris.L8PollarisExplain{Name: base.Name, Vendor: "cisco", Version: "17.4"})
+	if explain.Result == nil || explain.Result.Name != "explain-cisco" {
+		vnic.Resources().Logger().Fail(t, "Expected explain-cisco to be chosen")
+		return
+	}
+	if len(explain.TriedKeys) != 1 || explain.MatchedKey != "explain-cisco+cisco" {
+		vnic.Resources().Logger().Fail(t, "Unexpected keys ", explain.TriedKeys, " ", explain.MatchedKey)
+		return
+	}
+	if len(explain.Candidates) != 3 {
+		vnic.Resources().Logger().Fail(t, "Expected 3 candidates")
+		return
+	}
+	for _, candidate := range explain.Candidates {
+		if candidate.Chosen != (candidate.Name == "explain-cisco") {
+			vnic.Resources().Logger().Fail(t, "Unexpected candidate ", candidate.Name, " ", candidate.Reason)
+			return
+		}
+	}
+}
+
+// TestPollarisValidation verifies that semantic problems are rejected with
+// a structured list of errors located by field path:
+// 1. Rejects an unsupported operation, an invalid cadence and a malformed OID
+// 2. Adds only the valid pollarises of AddAll
+// 3. Rejects a rule the l8parser does not implement and accepts a known one
+// 4. Accepts a poll without a protocol or an operation
+func TestPollarisValidation(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	invalid := &l8tpollaris.L8Pollaris{Name: "invalid", Polling: map[string]*l8tpollaris.L8Poll{
+		"pods": {Name: "pods", What: "get pods", Protocol: l8tpollaris.L8PProtocol_L8PKubectl,
+			Operation: l8tpollaris.L8C_Operation_L8C_Table},
+		"sysname": {Name: "sysname", What: "1.3.6.x", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+			Operation: l8tpollaris.L8C_Operation_L8C_Get, Cadence: &l8tpollaris.L8PCadencePlan{Cadences: []int64{0}}},
+	}}
+	err := p.Post(invalid, false)
+	errs, ok := err.(pollaris.ValidationErrors)
+	if !ok {
+		vnic.Resources().Logger().Fail(t, "Expected validation errors but got ", err)
+		return
+	}
+	expected := map[string]bool{
+		"polling[pods].operation":              true,
+		"polling[sysname].cadence.cadences[0]": true,
+		"polling[sysname].what":                true,
+	}
+	if len(errs) != len(expected) {
+		vnic.Resources().Logger().Fail(t, "Unexpected validation errors ", errs.Error())
+		return
+	}
+	for _, e := range errs {
+		if !expected[e.Path] {
+			vnic.Resources().Logger().Fail(t, "Unexpected validation error ", e.Path, " ", e.Message)
+			return
+		}
+	}
+
+	valid := boot.CreateBoot01()
+	valid.Name = "add-all-valid"
+	if p.AddAll([]*l8tpollaris.L8Pollaris{invalid, valid}) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected AddAll to report the invalid pollaris")
+		return
+	}
+	if p.Definition(valid.Name) == nil || p.Definition(invalid.Name) != nil {
+		vnic.Resources().Logger().Fail(t, "Expected AddAll to add only the valid pollaris")
+		return
+	}
+
+	ruled := &l8tpollaris.L8Pollaris{Name: "ruled", Polling: map[string]*l8tpollaris.L8Poll{
+		"sysname": {Name: "sysname", What: ".1.3.6.1.2.1.1.5.0", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+			Operation: l8tpollaris.L8C_Operation_L8C_Get, Attributes: []*l8tpollaris.L8PAttribute{
+				{PropertyId: "networkdevice.equipmentinfo.sysname", Rules: []*l8tpollaris.L8PRule{
+					{Name: "Set"}, {Name: "bogus"}}}}}}}
+	err = p.Post(ruled, false)
+	errs, ok = err.(pollaris.ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].Path != "polling[sysname].attributes[0].rules[1].name" {
+		vnic.Resources().Logger().Fail(t, "Expected only the unknown rule to be rejected, got ", err)
+		return
+	}
+
+	defaults := &l8tpollaris.L8Pollaris{Name: "defaults", Polling: map[string]*l8tpollaris.L8Poll{
+		"version": {Name: "version", What: "show version"},
+		"uptime":  {Name: "uptime", What: "show uptime", Protocol: l8tpollaris.L8PProtocol_L8PSSH}}}
+	err = p.Post(defaults, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, "Expected polls without a protocol or an operation to be accepted, got ", err)
+		return
+	}
+}
+
+// TestPollarisModelsDirectory verifies that YAML and JSON model files, holding
+// a single pollaris or a list, are loaded from a directory and can be posted,
+// and that a watched directory follows added, edited and removed files while
+// keeping a loaded model whose edit is invalid or that moves to another file,
+// retrying a failed deletion, and including group definition files.
+func TestPollarisModelsDirectory(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	dir := t.TempDir()
+	yamlModel := "name: dirmodel\nvendor: acme\npolling:\n  sysname:\n    name: sysname\n    what: .1.3.6.1.2.1.1.5.0\n" +
+		"    protocol: L8PPSNMPV2\n    operation: L8C_Get\n"
+	jsonModels := `{"list": [{"name": "dirmodel2", "polling": {"pods": {"name": "pods", "what": "get pods",
+		"protocol": "L8PKubectl", "operation": "L8C_Map"}}}]}`
+	os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(yamlModel), 0644)
+	os.WriteFile(filepath.Join(dir, "b.json"), []byte(jsonModels), 0644)
+	os.WriteFile(filepath.Join(dir, "readme.txt"), []byte("ignored"), 0644)
+
+	models, err := pollaris.LoadModelsDirectory(dir)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	if len(models) != 2 || models[0].Name != "dirmodel" || models[1].Name != "dirmodel2" {
+		vnic.Resources().Logger().Fail(t, "Unexpected models loaded ", len(models))
+		return
+	}
+	for _, model := range models {
+		err = p.Post(model, false)
+		if err != nil {
+			vnic.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+	if p.PollarisByName("dirmodel").Vendor != "acme" || p.PollarisByName("dirmodel2") == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the directory models to be posted")
+		return
+	}
+
+	// the directory is watched by a service activated with ModelsDirectory set
+	watched := t.TempDir()
+	watchedFile := filepath.Join(watched, "watched.yaml")
+	os.WriteFile(watchedFile, []byte(strings.Replace(yamlModel, "dirmodel", "watched", 1)), 0644)
+	groupFile := filepath.Join(watched, "groups.yaml")
+	os.WriteFile(groupFile, []byte("group: watched-all\nincludes:\n  - watched-boot\n"), 0644)
+	pollaris.ModelsDirectory = watched
+	pollaris.ModelsReloadInterval = time.Millisecond * 10
+	defer func() {
+		pollaris.ModelsDirectory = ""
+		pollaris.ModelsReloadInterval = time.Second * 10
+	}()
+	watcherVnic := topo.VnicByVnetNum(3, 3)
+	w := activatePollaris(watcherVnic)
+	handler, _ := watcherVnic.Resources().Services().ServiceHandler(pollaris.ServiceName, pollaris.ServiceArea)
+	defer handler.DeActivate()
+	if w.Definition("watched") == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the watched model to be loaded on activation")
+		return
+	}
+	includes := w.GroupIncludes("watched-all")
+	if len(includes) != 1 || includes[0] != "watched-boot" {
+		vnic.Resources().Logger().Fail(t, "Expected the watched group definition to be loaded on activation")
+		return
+	}
+
+	// an edit that makes the model invalid keeps the loaded definition
+	edited := `{"list": [{"name": "watched", "polling": {"pods": {"name": "pods", "what": "get pods",
+		"protocol": "L8PKubectl", "operation": "L8C_Table"}}}, {"name": "watched-2", "polling": {"pods": {
+		"name": "pods", "what": "get pods", "protocol": "L8PKubectl", "operation": "L8C_Map"}}}]}`
+	os.WriteFile(watchedFile, []byte(edited), 0644)
+	if !waitFor(func() bool { return w.Definition("watched-2") != nil }) {
+		vnic.Resources().Logger().Fail(t, "Expected the edited file to be reloaded")
+		return
+	}
+	if w.Definition("watched") == nil || w.Definition("watched").Vendor != "acme" {
+		vnic.Resources().Logger().Fail(t, "Expected the invalid edit to keep the loaded model")
+		return
+	}
+
+	// moving a model to an earlier file keeps it
+	model := func(name, vendor string) string {
+		return `{"name": "` + name + `", "vendor": "` + vendor + `", "polling": {"pods": {"name": "pods",
+		"what": "get pods", "protocol": "L8PKubectl", "operation": "L8C_Map"}}}`
+	}
+	movedFile := filepath.Join(watched, "a.json")
+	os.WriteFile(movedFile, []byte(`{"list": [`+model("watched-2", "moved")+`, `+model("watched-base", "")+`]}`), 0644)
+	if !waitFor(func() bool {
+		return w.Definition("watched-2").GetVendor() == "moved" && w.Definition("watched-base") != nil
+	}) {
+		vnic.Resources().Logger().Fail(t, "Expected the models of the new file to be loaded")
+		return
+	}
+	os.WriteFile(watchedFile, []byte(strings.Replace(edited, "watched-2", "watched-3", 1)), 0644)
+	if !waitFor(func() bool { return w.Definition("watched-3") != nil }) {
+		vnic.Resources().Logger().Fail(t, "Expected the edited file to be reloaded")
+		return
+	}
+	if w.Definition("watched-2") == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the model moved to another file to be kept")
+		return
+	}
+
+	// a model whose deletion fails is deleted on a later scan
+	err = w.Post(&l8tpollaris.L8Pollaris{Name: "watched-child", Extends: "watched-base"}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	os.WriteFile(movedFile, []byte(`{"list": [`+model("watched-2", "moved-again")+`]}`), 0644)
+	if !waitFor(func() bool { return w.Definition("watched-2").GetVendor() == "moved-again" }) {
+		vnic.Resources().Logger().Fail(t, "Expected the moved file to be reloaded")
+		return
+	}
+	if w.Definition("watched-base") == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the extended model to be kept")
+		return
+	}
+	err = w.Delete(&l8tpollaris.L8Pollaris{Name: "watched-child"}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	if !waitFor(func() bool { return w.Definition("watched-base") == nil }) {
+		vnic.Resources().Logger().Fail(t, "Expected the deletion of the model to be retried")
+		return
+	}
+
+	// removing the file deletes its models
+	os.Remove(watchedFile)
+	if !waitFor(func() bool { return w.Definition("watched") == nil && w.Definition("watched-3") == nil }) {
+		vnic.Resources().Logger().Fail(t, "Expected the models of the removed file to be deleted")
+		return
+	}
+	if w.Definition("watched-2") == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the models of the other file to be kept")
+		return
+	}
+
+	// editing the group definition file redefines the group, removing it removes the nesting
+	os.WriteFile(groupFile, []byte("group: watched-all\nincludes:\n  - watched-boot\n  - watched-interfaces\n"), 0644)
+	if !waitFor(func() bool { return len(w.GroupIncludes("watched-all")) == 2 }) {
+		vnic.Resources().Logger().Fail(t, "Expected the edited group definition to be reloaded")
+		return
+	}
+	os.Remove(groupFile)
+	if !waitFor(func() bool { return len(w.GroupIncludes("watched-all")) == 0 }) {
+		vnic.Resources().Logger().Fail(t, "Expected the nesting of the removed group definition to be removed")
+		return
+	}
+}
+
+// TestPollarisBundle verifies exporting pollarises and group definitions to
+// a bundle file and importing it back with the skip, rename and overwrite
+// conflict policies.
+func TestPollarisBundle(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	base := &l8tpollaris.L8Pollaris{Name: "bundled", Vendor: "bundlevendor", Groups: []string{"bundlegroup"},
+		Polling: map[string]*l8tpollaris.L8Poll{"pods": {Name: "pods", What: "get pods",
+			Protocol: l8tpollaris.L8PProtocol_L8PKubectl, Operation: l8tpollaris.L8C_Operation_L8C_Map}}}
+	err := p.Post(base, false)
+	if err == nil {
+		err = p.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "bundlegroup",
+			Includes: []string{"bundleincluded"}}, false)
+	}
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	bundle := p.Export([]string{"bundlegroup"}, nil)
+	if len(bundle.List) != 1 || bundle.List[0].Name != "bundled" {
+		vnic.Resources().Logger().Fail(t, "Expected only the bundled pollaris to be exported")
+		return
+	}
+	if len(bundle.Groups) != 1 || bundle.Groups[0].Group != "bundlegroup" {
+		vnic.Resources().Logger().Fail(t, "Expected only the bundled group definition to be exported")
+		return
+	}
+	path := filepath.Join(t.TempDir(), "bundle.yaml")
+	err = pollaris.WriteBundle(bundle, path)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	bundle, err = pollaris.ReadBundle(path)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	results, err := p.Import(bundle, l8tpollaris.L8PConflictPolicy_L8PConflict_Skip, false)
+	if err != nil || results[0].Status != l8tpollaris.L8PImportStatus_L8PImport_Unchanged ||
+		results[1].Group != "bundlegroup" || results[1].Status != l8tpollaris.L8PImportStatus_L8PImport_Unchanged {
+		vnic.Resources().Logger().Fail(t, "Expected an identical pollaris and group definition to be unchanged")
+		return
+	}
+	bundle.List[0].Vendor = "othervendor"
+	bundle.Groups[0].Includes = []string{"bundleother"}
+	results, _ = p.Import(bundle, l8tpollaris.L8PConflictPolicy_L8PConflict_Skip, false)
+	if results[0].Status != l8tpollaris.L8PImportStatus_L8PImport_Skipped || p.Definition("bundled").Vendor != "bundlevendor" {
+		vnic.Resources().Logger().Fail(t, "Expected a conflicting pollaris to be skipped")
+		return
+	}
+	if results[1].Status != l8tpollaris.L8PImportStatus_L8PImport_Skipped || p.GroupIncludes("bundlegroup")[0] != "bundleincluded" {
+		vnic.Resources().Logger().Fail(t, "Expected a conflicting group definition to be skipped")
+		return
+	}
+	results, _ = p.Import(bundle, l8tpollaris.L8PConflictPolicy_L8PConflict_Rename, false)
+	if results[0].Status != l8tpollaris.L8PImportStatus_L8PImport_Renamed || p.Definition(results[0].StoredAs) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected a conflicting pollaris to be renamed")
+		return
+	}
+	if results[1].Status != l8tpollaris.L8PImportStatus_L8PImport_Skipped {
+		vnic.Resources().Logger().Fail(t, "Expected a conflicting group definition not to be renamed")
+		return
+	}
+	results, _ = p.Import(bundle, l8tpollaris.L8PConflictPolicy_L8PConflict_Overwrite, false)
+	if results[1].Status != l8tpollaris.L8PImportStatus_L8PImport_Overwritten || p.GroupIncludes("bundlegroup")[0] != "bundleother" {
+		vnic.Resources().Logger().Fail(t, "Expected a conflicting group definition to be overwritten")
+		return
+	}
+}
+
+// TestPollarisProviders verifies that models with the same name from several
+// providers are resolved by provider priority.
+func TestPollarisProviders(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	low := pollaris.NewModelProvider("low", 10, func() ([]*l8tpollaris.L8Pollaris, error) {
+		return []*l8tpollaris.L8Pollaris{{Name: "provided", Vendor: "low"}, {Name: "lowonly"}}, nil
+	})
+	high := pollaris.NewModelProvider("high", 20, func() ([]*l8tpollaris.L8Pollaris, error) {
+		return []*l8tpollaris.L8Pollaris{{Name: "provided", Vendor: "high"}}, nil
+	})
+	pollaris.RegisterProvider(high)
+	pollaris.RegisterProvider(low)
+	defer pollaris.UnregisterProvider("low")
+	defer pollaris.UnregisterProvider("high")
+
+	found := map[string]*l8tpollaris.L8Pollaris{}
+	for _, model := range pollaris.ProviderModels(vnic.Resources().Logger()) {
+		found[model.Name] = model
+	}
+	if found["provided"] == nil || found["provided"].Vendor != "high" {
+		vnic.Resources().Logger().Fail(t, "Expected the high priority provider to win")
+		return
+	}
+	if found["lowonly"] == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the low priority provider models to be included")
+		return
+	}
+}
+
+// TestPollarisFileStore verifies that the file store saves, loads and
+// deletes pollaris definitions, and saves and loads group definitions.
+func TestPollarisFileStore(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	store, err := pollaris.NewFileStore(filepath.Join(t.TempDir(), "store"))
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	stored := &l8tpollaris.L8Pollaris{Name: "stored/model", Revision: 3, Polling: map[string]*l8tpollaris.L8Poll{
+		"pods": {Name: "pods", What: "get pods", Protocol: l8tpollaris.L8PProtocol_L8PKubectl,
+			Operation: l8tpollaris.L8C_Operation_L8C_Map}}}
+	err = store.Save(stored)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	loaded, err := store.Load()
+	if err != nil || len(loaded) != 1 || !proto.Equal(loaded[0], stored) {
+		vnic.Resources().Logger().Fail(t, "Expected the stored pollaris to be loaded")
+		return
+	}
+	err = store.Delete(stored.Name)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	loaded, _ = store.Load()
+	if len(loaded) != 0 {
+		vnic.Resources().Logger().Fail(t, "Expected the stored pollaris to be deleted")
+		return
+	}
+
+	definition := &l8tpollaris.L8PollarisGroupDefinition{Group: "stored/group", Includes: []string{"a", "b"},
+		Modified: 7, Origin: "origin"}
+	err = store.SaveGroup(definition)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	definitions, err := store.LoadGroups()
+	if err != nil || len(definitions) != 1 || !proto.Equal(definitions[0], definition) {
+		vnic.Resources().Logger().Fail(t, "Expected the stored group definition to be loaded")
+		return
+	}
+	loaded, _ = store.Load()
+	if len(loaded) != 0 {
+		vnic.Resources().Logger().Fail(t, "Expected the group definitions not to be loaded as pollarises")
+		return
+	}
+}
+
+// memoryOrm is an in-memory ORM keeping the rows of each type by key, standing
+// for the database of an OrmStore.
+type memoryOrm struct {
+	rows map[string]map[string]proto.Message
+}
+
+// Read returns the rows of the queried type on the first page.
+func (this *memoryOrm) Read(query ifs.IQuery, resources ifs.IResources) ifs.IElements {
+	if query.Page() > 0 {
+		return object.New(nil, nil)
+	}
+	fields := strings.Fields(query.Text())
+	result := make([]interface{}, 0)
+	for _, row := range this.rows[fields[3]] {
+		result = append(result, proto.Clone(row))
+	}
+	return object.New(nil, result)
+}
+
+// Write puts or deletes the rows, keyed by pollaris name or group.
+func (this *memoryOrm) Write(action ifs.Action, elems ifs.IElements, resources ifs.IResources) error {
+	for _, elem := range elems.Elements() {
+		typeName, key := "L8Pollaris", ""
+		switch row := elem.(type) {
+		case *l8tpollaris.L8Pollaris:
+			key = row.Name
+		case *l8tpollaris.L8PollarisGroupDefinition:
+			typeName, key = "L8PollarisGroupDefinition", row.Group
+		}
+		if this.rows[typeName] == nil {
+			this.rows[typeName] = make(map[string]proto.Message)
+		}
+		if action == ifs.DELETE {
+			delete(this.rows[typeName], key)
+			continue
+		}
+		this.rows[typeName][key] = proto.Clone(elem.(proto.Message))
+	}
+	return nil
+}
+
+// TestPollarisOrmStore verifies that the ORM store keeps the pollarises and
+// the group definitions of a tenant area apart from the global ones.
+func TestPollarisOrmStore(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	store := pollaris.NewOrmStore(&memoryOrm{rows: make(map[string]map[string]proto.Message)}, vnic.Resources())
+	tenant := store.ForArea(3)
+	global := &l8tpollaris.L8Pollaris{Name: "orm-model", Vendor: "global"}
+	area := &l8tpollaris.L8Pollaris{Name: "orm-model", Vendor: "tenant"}
+	definition := &l8tpollaris.L8PollarisGroupDefinition{Group: "orm-group", Includes: []string{"a"}}
+	if store.Save(global) != nil || tenant.Save(area) != nil || tenant.(pollaris.GroupStore).SaveGroup(definition) != nil {
+		vnic.Resources().Logger().Fail(t, "Expected the rows to be saved")
+		return
+	}
+	if area.Name != "orm-model" || definition.Group != "orm-group" {
+		vnic.Resources().Logger().Fail(t, "Expected the saved definitions not to be modified")
+		return
+	}
+	loaded, err := store.Load()
+	if err != nil || len(loaded) != 1 || !proto.Equal(loaded[0], global) {
+		vnic.Resources().Logger().Fail(t, "Expected only the global pollaris in the global area")
+		return
+	}
+	loaded, err = tenant.Load()
+	if err != nil || len(loaded) != 1 || !proto.Equal(loaded[0], area) {
+		vnic.Resources().Logger().Fail(t, "Expected only the tenant pollaris in the tenant area")
+		return
+	}
+	definitions, _ := store.LoadGroups()
+	if len(definitions) != 0 {
+		vnic.Resources().Logger().Fail(t, "Expected no group definition in the global area")
+		return
+	}
+	definitions, _ = tenant.(pollaris.GroupStore).LoadGroups()
+	if len(definitions) != 1 || !proto.Equal(definitions[0], definition) {
+		vnic.Resources().Logger().Fail(t, "Expected the group definition in the tenant area")
+		return
+	}
+	err = tenant.(*pollaris.OrmStore).Delete(area.Name)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	loaded, _ = tenant.Load()
+	remaining, _ := store.Load()
+	if len(loaded) != 0 || len(remaining) != 1 {
+		vnic.Resources().Logger().Fail(t, "Expected only the tenant pollaris to be deleted")
+		return
+	}
+}
+
+// TestPollarisRestart verifies that the models created, edited and deleted at
+// runtime survive a restart through the PollarisStore:
+// 1. Activates the service with a file store and the provider models
+// 2. Posts a model, edits a provider model, deletes another one and nests a group
+// 3. Activates the service again on the same store and verifies the changes
+func TestPollarisRestart(t *testing.T) {
+	vnic := topo.VnicByVnetNum(3, 1)
+	store, err := pollaris.NewFileStore(filepath.Join(t.TempDir(), "store"))
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	pollaris.PollarisStore = store
+	defer func() { pollaris.PollarisStore = nil }()
+	provided := pollaris.ProviderModels(vnic.Resources().Logger())
+	if len(provided) < 2 {
+		vnic.Resources().Logger().Fail(t, "Expected at least 2 provider models")
+		return
+	}
+	editedName, deletedName := provided[0].Name, provided[1].Name
+
+	err = pollaris.Activate(vnic)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	p := pollaris.Pollaris(vnic.Resources())
+	created := boot.CreateBoot01()
+	created.Name = "restart-created"
+	created.Groups = []string{"restart-group"}
+	err = p.Post(created, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	edited := proto.Clone(p.Definition(editedName)).(*l8tpollaris.L8Pollaris)
+	edited.Vendor = "restart-vendor"
+	err = p.Put(edited, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	err = p.Delete(&l8tpollaris.L8Pollaris{Name: deletedName}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	err = p.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "restart-all",
+		Includes: []string{"restart-group"}}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	restarted := topo.VnicByVnetNum(3, 2)
+	err = pollaris.Activate(restarted)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	r := pollaris.Pollaris(restarted.Resources())
+	if r.Definition(created.Name) == nil || len(r.Names("restart-group", "", "", "", "", "", "")) != 1 {
+		vnic.Resources().Logger().Fail(t, "Expected the created model to be reloaded into the indexes")
+		return
+	}
+	if r.Definition(editedName) == nil || r.Definition(editedName).Vendor != "restart-vendor" {
+		vnic.Resources().Logger().Fail(t, "Expected the edited provider model to be reloaded")
+		return
+	}
+	if r.Definition(deletedName) != nil {
+		vnic.Resources().Logger().Fail(t, "Expected the deleted provider model not to be restored")
+		return
+	}
+	if len(r.Names("restart-all", "", "", "", "", "", "")) != 1 {
+		vnic.Resources().Logger().Fail(t, "Expected the group definition to be restored")
+		return
+	}
+
+	// posting a deleted model again replaces its tombstone
+	err = r.Post(provided[1], false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	loaded, err := store.Load()
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	for _, l8pollaris := range loaded {
+		if l8pollaris.Name == deletedName && l8pollaris.Deleted {
+			vnic.Resources().Logger().Fail(t, "Expected the tombstone to be replaced")
+			return
+		}
+	}
+}
+
+// TestPollarisReplicationOrder verifies that changes received from peers are
+// ordered by revision, with last-writer-wins for the same revision.
+func TestPollarisReplicationOrder(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	err := p.Post(&l8tpollaris.L8Pollaris{Name: "replicated", Polling: map[string]*l8tpollaris.L8Poll{
+		"pods": {Name: "pods", What: "get pods", Protocol: l8tpollaris.L8PProtocol_L8PKubectl,
+			Operation: l8tpollaris.L8C_Operation_L8C_Map}}}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	revision := p.Definition("replicated").Revision
+
+	peerChange := func(vendor string, revision, modified int64) *l8tpollaris.L8Pollaris {
+		change := proto.Clone(p.Definition("replicated")).(*l8tpollaris.L8Pollaris)
+		change.Vendor = vendor
+		change.Revision = revision
+		change.Modified = modified
+		change.Origin = "peer"
+		return change
+	}
+	modified := p.Definition("replicated").Modified
+	p.Put(peerChange("newer", revision+2, modified+1), true)
+	p.Put(peerChange("stale", revision+1, modified+2), true)
+	if p.Definition("replicated").Vendor != "newer" {
+		vnic.Resources().Logger().Fail(t, "Expected the stale change to be ignored")
+		return
+	}
+	p.Put(peerChange("tie", revision+2, modified+3), true)
+	if p.Definition("replicated").Vendor != "tie" {
+		vnic.Resources().Logger().Fail(t, "Expected the last writer to win for the same revision")
+		return
+	}
+	p.Delete(peerChange("tie", revision, modified), true)
+	if p.Definition("replicated") == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the stale delete to be ignored")
+		return
+	}
+}
+
+// TestPollarisSnapshot verifies the snapshot replication between instances:
+// 1. Takes the snapshot of the definitions of the service leader
+// 2. Pulls the snapshot of the leader, with its group definitions, into another instance
+// 3. Trusts the snapshot request of a peer and denies the one of a client
+// 4. Merges a snapshot with an older revision without applying it
+func TestPollarisSnapshot(t *testing.T) {
+	area := byte(71)
+	vnics := []ifs.IVNic{topo.VnicByVnetNum(1, 1), topo.VnicByVnetNum(1, 2)}
+	for _, vnic := range vnics {
+		err := pollaris.ActivateArea(area, vnic)
+		if err != nil {
+			vnic.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+	leaderUuid := vnics[0].Resources().Services().GetLeader(pollaris.ServiceName, area)
+	leader, follower := vnics[0], vnics[1]
+	if leaderUuid == follower.Resources().SysConfig().LocalUuid {
+		leader, follower = follower, leader
+	}
+	if leaderUuid != leader.Resources().SysConfig().LocalUuid {
+		leader.Resources().Logger().Fail(t, "Expected one of the instances to be the leader")
+		return
+	}
+	l := pollaris.PollarisOfArea(leader.Resources(), area)
+	f := pollaris.PollarisOfArea(follower.Resources(), area)
+	for _, name := range []string{"snapshot-a", "snapshot-b"} {
+		pollrs := boot.CreateBoot01()
+		pollrs.Name = name
+		err := l.Post(pollrs, false)
+		if err != nil {
+			leader.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+	older := proto.Clone(l.Definition("snapshot-a")).(*l8tpollaris.L8Pollaris)
+	older.Vendor = "older"
+	updated := proto.Clone(l.Definition("snapshot-a")).(*l8tpollaris.L8Pollaris)
+	updated.Vendor = "updated"
+	err := l.Put(updated, false)
+	if err == nil {
+		err = l.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "snapshot-all",
+			Includes: []string{"boot01"}}, false)
+	}
+	if err != nil {
+		leader.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	snapshot := l.Snapshot()
+	if len(snapshot) != 2 || snapshot[0].Name != "snapshot-a" || snapshot[1].Name != "snapshot-b" {
+		leader.Resources().Logger().Fail(t, "Expected the snapshot to hold the 2 definitions")
+		return
+	}
+
+	if l.PullSnapshot(leader) != nil {
+		leader.Resources().Logger().Fail(t, "Expected the leader not to pull its own snapshot")
+		return
+	}
+	// the snapshot request of a peer is trusted, the one of a client is not
+	pollaris.PollarisAuthorizer = pollaris.NewRoleAuthorizer()
+	defer func() { pollaris.PollarisAuthorizer = nil }()
+	resp := topo.VnicByVnetNum(2, 2).Request(leaderUuid, pollaris.ServiceName, area, ifs.POST,
+		&l8tpollaris.L8PollarisSnapshot{}, 5, "client-id")
+	if resp.Error() == nil || !strings.Contains(resp.Error().Error(), "not permitted") {
+		leader.Resources().Logger().Fail(t, "Expected the snapshot request of a client to be denied")
+		return
+	}
+	err = f.PullSnapshot(follower)
+	if err != nil {
+		follower.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	pollaris.PollarisAuthorizer = nil
+	pulled := f.Definition("snapshot-a")
+	if pulled == nil || pulled.Vendor != "updated" || pulled.Revision != updated.Revision || f.Definition("snapshot-b") == nil {
+		follower.Resources().Logger().Fail(t, "Expected the leader snapshot to be pulled with its revisions")
+		return
+	}
+	groupDefinition := f.GroupDefinition("snapshot-all")
+	if groupDefinition == nil || !proto.Equal(groupDefinition, l.GroupDefinition("snapshot-all")) {
+		follower.Resources().Logger().Fail(t, "Expected the group definitions to be pulled with the snapshot")
+		return
+	}
+
+	err = f.Merge([]*l8tpollaris.L8Pollaris{older})
+	if err != nil {
+		follower.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	if f.Definition("snapshot-a").Vendor != "updated" {
+		follower.Resources().Logger().Fail(t, "Expected the older revision not to be merged")
+		return
+	}
+}
+
+// TestPollarisSubscribe verifies that in-process listeners receive the added,
+// updated and removed events with the old and new definitions, of the
+// pollarises and of the group definitions, including the merged ones.
+func TestPollarisSubscribe(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	events := make([]*l8tpollaris.L8PollarisEvent, 0)
+	p.Subscribe("test", func(event *l8tpollaris.L8PollarisEvent) {
+		if event.Name == "subscribed" || event.Name == "subscribedbase" {
+			events = append(events, event)
+		}
+	})
+	defer p.Unsubscribe("test")
+
+	base := &l8tpollaris.L8Pollaris{Name: "subscribedbase", Polling: map[string]*l8tpollaris.L8Poll{
+		"pods": {Name: "pods", What: "get pods", Protocol: l8tpollaris.L8PProtocol_L8PKubectl,
+			Operation: l8tpollaris.L8C_Operation_L8C_Map}}}
+	p.Post(base, false)
+	p.Post(&l8tpollaris.L8Pollaris{Name: "subscribed", Extends: "subscribedbase"}, false)
+	p.Patch(&l8tpollaris.L8Pollaris{Name: "subscribedbase", Vendor: "acme"}, false)
+	p.Delete(&l8tpollaris.L8Pollaris{Name: "subscribed"}, false)
+
+	expected := []l8tpollaris.L8PEventType{l8tpollaris.L8PEventType_L8PEvent_Added,
+		l8tpollaris.L8PEventType_L8PEvent_Added, l8tpollaris.L8PEventType_L8PEvent_Updated,
+		l8tpollaris.L8PEventType_L8PEvent_Removed}
+	if len(events) != len(expected) {
+		vnic.Resources().Logger().Fail(t, "Expected ", len(expected), " events but got ", len(events))
+		return
+	}
+	for i, event := range events {
+		if event.Type != expected[i] {
+			vnic.Resources().Logger().Fail(t, "Unexpected event ", i, " ", event.Type.String())
+			return
+		}
+	}
+	updated := events[2]
+	if updated.Old.Vendor != "" || updated.New.Vendor != "acme" {
+		vnic.Resources().Logger().Fail(t, "Expected the old and new definitions in the update event")
+		return
+	}
+	if len(updated.Affected) != 1 || updated.Affected[0] != "subscribed" {
+		vnic.Resources().Logger().Fail(t, "Expected the variant to be affected by the base update")
+		return
+	}
+
+	groupEvents := make([]*l8tpollaris.L8PollarisEvent, 0)
+	p.Subscribe("groups", func(event *l8tpollaris.L8PollarisEvent) {
+		if event.Group == "subscribed-all" {
+			groupEvents = append(groupEvents, event)
+		}
+	})
+	defer p.Unsubscribe("groups")
+	p.Post(&l8tpollaris.L8Pollaris{Name: "subscribedmember", Groups: []string{"subscribed-members"},
+		Polling: base.Polling}, false)
+	definition := &l8tpollaris.L8PollarisGroupDefinition{Group: "subscribed-all", Includes: []string{"subscribed-members"}}
+	p.DefineGroup(definition, false)
+	p.DefineGroup(definition, false)
+	p.MergeGroups([]*l8tpollaris.L8PollarisGroupDefinition{{Group: "subscribed-all",
+		Includes: []string{"subscribed-members", "subscribed-others"}, Modified: time.Now().Add(time.Hour).UnixNano(),
+		Origin: "peer"}})
+	p.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "subscribed-all"}, false)
+
+	expected = []l8tpollaris.L8PEventType{l8tpollaris.L8PEventType_L8PEvent_Added,
+		l8tpollaris.L8PEventType_L8PEvent_Updated, l8tpollaris.L8PEventType_L8PEvent_Removed}
+	if len(groupEvents) != len(expected) {
+		vnic.Resources().Logger().Fail(t, "Expected ", len(expected), " group events but got ", len(groupEvents))
+		return
+	}
+	for i, event := range groupEvents {
+		if event.Type != expected[i] || len(event.Affected) != 1 || event.Affected[0] != "subscribedmember" {
+			vnic.Resources().Logger().Fail(t, "Unexpected group event ", i, " ", event.Type.String(), " ", event.Affected)
+			return
+		}
+	}
+	if groupEvents[0].OldGroup != nil || len(groupEvents[1].NewGroup.GetIncludes()) != 2 || groupEvents[2].NewGroup != nil {
+		vnic.Resources().Logger().Fail(t, "Expected the old and new group definitions in the group events")
+		return
+	}
+}
+
+// TestPollarisDiff verifies the changes reported between two revisions of a
+// pollaris and their text rendering.
+func TestPollarisDiff(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	err := p.Post(&l8tpollaris.L8Pollaris{Name: "diffed", Groups: []string{"a"}, Polling: map[string]*l8tpollaris.L8Poll{
+		"pods": {Name: "pods", What: "get pods", Protocol: l8tpollaris.L8PProtocol_L8PKubectl,
+			Operation: l8tpollaris.L8C_Operation_L8C_Map, Cadence: &l8tpollaris.L8PCadencePlan{Cadences: []int64{300}}},
+		"nodes": {Name: "nodes", What: "get nodes", Protocol: l8tpollaris.L8PProtocol_L8PKubectl,
+			Operation: l8tpollaris.L8C_Operation_L8C_Map}}}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	from := p.Definition("diffed").Revision
+	err = p.Patch(&l8tpollaris.L8Pollaris{Name: "diffed", Groups: []string{"b"}, RemoveGroups: []string{"a"},
+		RemovePolls: []string{"nodes"}, Polling: map[string]*l8tpollaris.L8Poll{
+			"pods": {Cadence: &l8tpollaris.L8PCadencePlan{Cadences: []int64{600}}}}}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	diff, err := p.DiffOf(&l8tpollaris.L8PollarisDiff{Name: "diffed", FromRevision: from})
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	expected := "+ groups: b\n" +
+		"- groups: a\n" +
+		"- polling[nodes]: L8PKubectl L8C_Map get nodes\n" +
+		"~ polling[pods].cadence.cadences: [300] -> [600]\n"
+	if diff.Text != expected {
+		vnic.Resources().Logger().Fail(t, "Unexpected diff:\n", diff.Text)
+		return
+	}
+}
+
+// TestPollarisLookupActions verifies the key lookup, group resolution and
+// single poll reads of the service, served on GET and on POST, and that
+// their results are copies that do not alias the cached definitions.
+func TestPollarisLookupActions(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	boot01 := boot.CreateBoot01()
+	err := p.Post(boot01, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	handler, _ := vnic.Resources().Services().ServiceHandler(pollaris.ServiceName, 0)
+
+	resp := handler.Post(object.New(nil, &l8tpollaris.L8PollarisLookup{Name: boot01.Name}), vnic)
+	lookup, ok := resp.Element().(*l8tpollaris.L8PollarisLookup)
+	if resp.Error() != nil || !ok || lookup.Result == nil || lookup.Result.Name != boot01.Name {
+		vnic.Resources().Logger().Fail(t, "Expected the lookup to return ", boot01.Name)
+		return
+	}
+	resp = handler.Get(object.New(nil, &l8tpollaris.L8PollarisLookup{Name: boot01.Name}), vnic)
+	lookup, ok = resp.Element().(*l8tpollaris.L8PollarisLookup)
+	if resp.Error() != nil || !ok || lookup.Result == nil || lookup.Result.Name != boot01.Name {
+		vnic.Resources().Logger().Fail(t, "Expected the lookup on GET to return ", boot01.Name)
+		return
+	}
+	lookup.Result.Groups = append(lookup.Result.Groups, "aliased")
+
+	resp = handler.Post(object.New(nil, &l8tpollaris.L8PollarisGroup{Group: common.BOOT_STAGE_01}), vnic)
+	group, ok := resp.Element().(*l8tpollaris.L8PollarisGroup)
+	if resp.Error() != nil || !ok || len(group.List) == 0 {
+		vnic.Resources().Logger().Fail(t, "Expected pollarises in group ", common.BOOT_STAGE_01)
+		return
+	}
+	resp = handler.Get(object.New(nil, &l8tpollaris.L8PollarisGroup{Group: common.BOOT_STAGE_01}), vnic)
+	group, ok = resp.Element().(*l8tpollaris.L8PollarisGroup)
+	if resp.Error() != nil || !ok || len(group.List) == 0 {
+		vnic.Resources().Logger().Fail(t, "Expected pollarises in group ", common.BOOT_STAGE_01, " on GET")
+		return
+	}
+	group.List[0].Groups = append(group.List[0].Groups, "aliased")
+
+	pollName := sortedKeys(boot01.Polling)[0]
+	resp = handler.Post(object.New(nil, &l8tpollaris.L8PollarisPoll{PollarisName: boot01.Name, PollName: pollName}), vnic)
+	poll, ok := resp.Element().(*l8tpollaris.L8PollarisPoll)
+	if resp.Error() != nil || !ok || poll.Poll == nil || poll.Poll.Name != pollName {
+		vnic.Resources().Logger().Fail(t, "Expected the poll ", pollName)
+		return
+	}
+	resp = handler.Get(object.New(nil, &l8tpollaris.L8PollarisPoll{PollarisName: boot01.Name, PollName: pollName}), vnic)
+	poll, ok = resp.Element().(*l8tpollaris.L8PollarisPoll)
+	if resp.Error() != nil || !ok || poll.Poll == nil || poll.Poll.Name != pollName {
+		vnic.Resources().Logger().Fail(t, "Expected the poll ", pollName, " on GET")
+		return
+	}
+	poll.Poll.What = "aliased"
+	resp = handler.Post(object.New(nil, &l8tpollaris.L8PollarisPoll{PollarisName: boot01.Name, PollName: "missing"}), vnic)
+	if resp.Error() == nil {
+		vnic.Resources().Logger().Fail(t, "Expected an error for a missing poll")
+		return
+	}
+
+	cached := p.PollarisByName(boot01.Name)
+	if contains(cached.Groups, "aliased") || cached.Polling[pollName].What == "aliased" {
+		vnic.Resources().Logger().Fail(t, "Expected the read results not to alias the cached pollaris")
+		return
+	}
+}
+
+// TestPollarisTenancy verifies the tenant namespaces of service areas:
+// 1. Activates a tenant area next to the global one
+// 2. Posts a tenant override of boot01 and verifies it shadows the global one
+// 3. Verifies a pollaris defined only globally resolves through the tenant
+// 4. Explains lookups through the tenant, resolving tenant-first and keeping
+// the tenant candidates in the trace of a global fallback
+func TestPollarisTenancy(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	boot01 := boot.CreateBoot01()
+	err := p.Post(boot01, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	global := &l8tpollaris.L8Pollaris{Name: "global-only", Groups: []string{"tenancy"},
+		Polling: map[string]*l8tpollaris.L8Poll{"sysName": {Name: "sysName", What: ".1.3.6.1.2.1.1.5.0",
+			Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2, Operation: l8tpollaris.L8C_Operation_L8C_Get}}}
+	err = p.Post(global, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	err = pollaris.ActivateArea(5, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	tenant := pollaris.PollarisOfArea(vnic.Resources(), 5)
+	if tenant == nil || tenant == p {
+		vnic.Resources().Logger().Fail(t, "Expected a tenant center for area 5")
+		return
+	}
+
+	override := &l8tpollaris.L8Pollaris{Name: boot01.Name, Groups: boot01.Groups,
+		Polling: map[string]*l8tpollaris.L8Poll{"tenantPoll": {Name: "tenantPoll", What: ".1.3.6.1.2.1.1.1.0",
+			Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2, Operation: l8tpollaris.L8C_Operation_L8C_Get}}}
+	err = tenant.Post(override, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	fromTenant := tenant.PollarisByName(boot01.Name)
+	if fromTenant == nil || fromTenant.Polling["tenantPoll"] == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the tenant override of ", boot01.Name)
+		return
+	}
+	fromGlobal := p.PollarisByName(boot01.Name)
+	if fromGlobal == nil || fromGlobal.Polling["tenantPoll"] != nil {
+		vnic.Resources().Logger().Fail(t, "Expected the global ", boot01.Name, " not to change")
+		return
+	}
+
+	if tenant.PollarisByName("global-only") == nil {
+		vnic.Resources().Logger().Fail(t, "Expected global-only to resolve through the tenant")
+		return
+	}
+	if tenant.PollarisByKey("global-only") == nil {
+		vnic.Resources().Logger().Fail(t, "Expected global-only to resolve by key through the tenant")
+		return
+	}
+	if tenant.Definition("global-only") != nil {
+		vnic.Resources().Logger().Fail(t, "Expected the namespaces to stay separate")
+		return
+	}
+
+	explained := tenant.Explain(&l8tpollaris.L8PollarisExplain{Name: boot01.Name})
+	if explained.Result == nil || explained.Result.Polling["tenantPoll"] == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the tenant explain to resolve the tenant override")
+		return
+	}
+	poll := func(name string) map[string]*l8tpollaris.L8Poll {
+		return map[string]*l8tpollaris.L8Poll{name: {Name: name, What: ".1.3.6.1.2.1.1.3.0",
+			Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2, Operation: l8tpollaris.L8C_Operation_L8C_Get}}
+	}
+	err = p.Post(&l8tpollaris.L8Pollaris{Name: "explained", Polling: poll("globalPoll")}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	err = tenant.Post(&l8tpollaris.L8Pollaris{Name: "explained", Vendor: "cisco", Polling: poll("tenantPoll")}, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	explained = tenant.Explain(&l8tpollaris.L8PollarisExplain{Name: "explained", Vendor: "juniper"})
+	if explained.Result == nil || explained.Result.Polling["globalPoll"] == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the tenant explain to fall back to the global explained")
+		return
+	}
+	if len(explained.TriedKeys) != 2 || len(explained.Candidates) != 2 {
+		vnic.Resources().Logger().Fail(t, "Expected the tenant and global keys and candidates, got ",
+			explained.TriedKeys, " and ", len(explained.Candidates), " candidates")
+		return
+	}
+	if explained.Candidates[0].Chosen || explained.Candidates[0].Reason == "" || !explained.Candidates[1].Chosen {
+		vnic.Resources().Logger().Fail(t, "Expected the tenant candidate skipped and the global one chosen")
+		return
+	}
+}
+
+// dryRunRules is a rule engine and registry for the dry runs, overriding the
+// parser rules, that implements the "regex" and "trim" rules.
+type dryRunRules struct{}
+
+// Exists returns true for the rules the engine implements and the rule the
+// engine fails on.
+func (this *dryRunRules) Exists(name string) bool {
+	return name == "regex" || name == "trim" || name == "StringToCTable"
+}
+
+// Apply runs the named rule on the input.
+func (this *dryRunRules) Apply(name string, params map[string]*l8tpollaris.L8PParameter, input interface{}) (interface{}, error) {
+	value, ok := input.(string)
+	if !ok {
+		return nil, errors.New("input is not a string")
+	}
+	switch name {
+	case "regex":
+		match := regexp.MustCompile(params["pattern"].Value).FindStringSubmatch(value)
+		if len(match) < 2 {
+			return nil, errors.New("value does not match")
+		}
+		return match[1], nil
+	case "trim":
+		return strings.TrimSpace(value), nil
+	}
+	return nil, errors.New("unknown rule")
+}
+
+// TestPollarisDryRun verifies a dry run of a pollaris on recorded responses:
+// 1. Runs a poll whose attribute rules extract a value from its response
+// 2. Reports the failure of a rule the rule engine does not know
+// 3. Reports a poll without a recorded response, including an inherited one
+// 4. Rejects an invalid inline pollaris
+// 5. Runs the l8parser rules when no rule engine overrides them
+func TestPollarisDryRun(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	handler, _ := vnic.Resources().Services().ServiceHandler(pollaris.ServiceName, 0)
+	pollaris.DryRunRules = &dryRunRules{}
+	pollaris.Rules = &dryRunRules{}
+	defer func() { pollaris.DryRunRules, pollaris.Rules = nil, nil }()
+
+	base := &l8tpollaris.L8Pollaris{Name: "dryrun-base", Polling: map[string]*l8tpollaris.L8Poll{
+		"uptime": {Name: "uptime", What: "show uptime", Protocol: l8tpollaris.L8PProtocol_L8PSSH,
+			Operation: l8tpollaris.L8C_Operation_L8C_Get}}}
+	err := p.Post(base, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	model := &l8tpollaris.L8Pollaris{Name: "dryrun", Extends: base.Name, Polling: map[string]*l8tpollaris.L8Poll{
+		"version": {Name: "version", What: "show version", Protocol: l8tpollaris.L8PProtocol_L8PSSH,
+			Operation: l8tpollaris.L8C_Operation_L8C_Get,
+			Attributes: []*l8tpollaris.L8PAttribute{
+				{PropertyId: "networkdevice.equipmentinfo.version", Rules: []*l8tpollaris.L8PRule{
+					{Name: "regex", Params: map[string]*l8tpollaris.L8PParameter{
+						"pattern": {Name: "pattern", Value: "Version ([^,]+),"}}},
+					{Name: "trim"}}},
+				{PropertyId: "networkdevice.equipmentinfo.model", Rules: []*l8tpollaris.L8PRule{
+					{Name: "StringToCTable"}}}}}}}
+	request := &l8tpollaris.L8PollarisDryRun{Pollaris: model, Responses: []*l8tpollaris.L8PRecordedResponse{
+		{Protocol: l8tpollaris.L8PProtocol_L8PSSH, What: "show version",
+			Data: "Cisco IOS Software, Version 15.2(4)M7, RELEASE SOFTWARE"}}}
+
+	resp := handler.Post(object.New(nil, request), vnic)
+	dryRun, ok := resp.Element().(*l8tpollaris.L8PollarisDryRun)
+	if resp.Error() != nil || !ok || len(dryRun.Results) != 2 {
+		vnic.Resources().Logger().Fail(t, "Expected the results of the 2 polls, including the inherited one")
+		return
+	}
+	uptime, version := dryRun.Results[0], dryRun.Results[1]
+	if !uptime.NoResponse {
+		vnic.Resources().Logger().Fail(t, "Expected no response for the uptime poll")
+		return
+	}
+	if len(version.Values) != 2 || version.Values[0].Value != "15.2(4)M7" || version.Values[0].Error != "" {
+		vnic.Resources().Logger().Fail(t, "Expected the version to be extracted")
+		return
+	}
+	if !strings.Contains(version.Values[1].Error, "rules[0] StringToCTable: unknown rule") {
+		vnic.Resources().Logger().Fail(t, "Expected a failure of the unknown rule, got ", version.Values[1].Error)
+		return
+	}
+
+	invalid := proto.Clone(model).(*l8tpollaris.L8Pollaris)
+	invalid.Polling["version"].Operation = l8tpollaris.L8C_Operation_L8C_Table
+	resp = handler.Post(object.New(nil, &l8tpollaris.L8PollarisDryRun{Pollaris: invalid,
+		Responses: request.Responses}), vnic)
+	if resp.Error() == nil {
+		vnic.Resources().Logger().Fail(t, "Expected an invalid inline pollaris to be rejected")
+		return
+	}
+
+	pollaris.DryRunRules, pollaris.Rules = nil, nil
+	parsed := &l8tpollaris.L8Pollaris{Name: "dryrun-parsed", Polling: map[string]*l8tpollaris.L8Poll{
+		"version": {Name: "version", What: "show version", Protocol: l8tpollaris.L8PProtocol_L8PSSH,
+			Operation: l8tpollaris.L8C_Operation_L8C_Get, Attributes: []*l8tpollaris.L8PAttribute{
+				{PropertyId: "networkdevice.equipmentinfo.version", Rules: []*l8tpollaris.L8PRule{{Name: "Set"}}}}}}}
+	resp = handler.Post(object.New(nil, &l8tpollaris.L8PollarisDryRun{Pollaris: parsed,
+		Responses: request.Responses}), vnic)
+	dryRun, ok = resp.Element().(*l8tpollaris.L8PollarisDryRun)
+	if resp.Error() != nil || !ok || len(dryRun.Results) != 1 || len(dryRun.Results[0].Values) != 1 ||
+		dryRun.Results[0].Values[0].Error != "" || dryRun.Results[0].Values[0].Value != request.Responses[0].Data {
+		vnic.Resources().Logger().Fail(t, "Expected the l8parser rules to run by default")
+		return
+	}
+}
+
+// TestPollarisDependencies verifies the poll dependencies of a pollaris:
+// 1. Resolves the execution order of dependent polls
+// 2. Rejects a dependency cycle and a dependency on an unknown poll
+// 3. Rejects a base update that drops a poll a child depends on
+func TestPollarisDependencies(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	poll := func(name, what string, dependsOn ...string) *l8tpollaris.L8Poll {
+		return &l8tpollaris.L8Poll{Name: name, What: what, DependsOn: dependsOn,
+			Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2, Operation: l8tpollaris.L8C_Operation_L8C_Get}
+	}
+	l8pollaris := &l8tpollaris.L8Pollaris{Name: "dependencies", Polling: map[string]*l8tpollaris.L8Poll{
+		"ifTable":     poll("ifTable", ".1.3.6.1.2.1.2.2", "sysObjectID", "ifIndex"),
+		"ifIndex":     poll("ifIndex", ".1.3.6.1.2.1.2.2.1.1", "sysObjectID"),
+		"sysObjectID": poll("sysObjectID", ".1.3.6.1.2.1.1.2.0")}}
+	err := p.Post(l8pollaris, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	order, err := p.ExecutionOrder(l8pollaris.Name)
+	if err != nil || len(order) != 3 || order[0] != "sysObjectID" || order[1] != "ifIndex" || order[2] != "ifTable" {
+		vnic.Resources().Logger().Fail(t, "Unexpected execution order ", order)
+		return
+	}
+
+	cyclic := proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+	cyclic.Polling["sysObjectID"].DependsOn = []string{"ifTable"}
+	err = p.Put(cyclic, false)
+	if err == nil {
+		vnic.Resources().Logger().Fail(t, "Expected a dependency cycle to be rejected")
+		return
+	}
+	unknown := proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+	unknown.Polling["sysObjectID"].DependsOn = []string{"missing"}
+	err = p.Put(unknown, false)
+	if err == nil {
+		vnic.Resources().Logger().Fail(t, "Expected a dependency on an unknown poll to be rejected")
+		return
+	}
+
+	child := &l8tpollaris.L8Pollaris{Name: "dependencies-child", Extends: l8pollaris.Name,
+		Polling: map[string]*l8tpollaris.L8Poll{"ifAlias": poll("ifAlias", ".1.3.6.1.2.1.31.1.1.1.18", "ifIndex")}}
+	err = p.Post(child, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	breaking := proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+	delete(breaking.Polling, "ifIndex")
+	breaking.Polling["ifTable"].DependsOn = []string{"sysObjectID"}
+	err = p.Put(breaking, false)
+	if err == nil || !strings.Contains(err.Error(), child.Name) {
+		vnic.Resources().Logger().Fail(t, "Expected a base breaking the dependencies of its child to be rejected")
+		return
+	}
+	order, err = p.ExecutionOrder(child.Name)
+	if err != nil || len(order) != 4 {
+		vnic.Resources().Logger().Fail(t, "Expected the child dependencies to stay satisfied, got ", order)
+		return
+	}
+}
+
+// TestPollarisConditions verifies the conditional polls of a pollaris:
+// 1. Rejects a malformed poll condition
+// 2. Locates the malformed tail of a truncated condition at its end
+// 3. Selects the polls whose condition holds for the target host and prior results
+func TestPollarisConditions(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	l8pollaris := &l8tpollaris.L8Pollaris{Name: "conditions", Polling: map[string]*l8tpollaris.L8Poll{
+		"sysinfo": {Name: "sysinfo", What: ".1.3.6.1.2.1.1", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+			Operation: l8tpollaris.L8C_Operation_L8C_Map},
+		"netconf": {Name: "netconf", What: "get-config", Protocol: l8tpollaris.L8PProtocol_L8PNETCONF,
+			Operation: l8tpollaris.L8C_Operation_L8C_Get, Condition: "host.protocols contains 'NETCONF'"},
+		"volumes": {Name: "volumes", What: ".1.3.6.1.4.1.789", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+			Operation: l8tpollaris.L8C_Operation_L8C_Table,
+			Condition: "result.vendor in ('NetApp', 'EMC') and not result.model matches '^Sim'"}}}
+
+	malformed := proto.Clone(l8pollaris).(*l8tpollaris.L8Pollaris)
+	malformed.Polling["volumes"].Condition = "result.vendor = "
+	err := p.Post(malformed, false)
+	errs, ok := err.(pollaris.ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].Path != "polling[volumes].condition" {
+		vnic.Resources().Logger().Fail(t, "Expected a malformed condition to be rejected at polling[volumes].condition, got ", err)
+		return
+	}
+	_, err = condition.Parse("result.model matches")
+	if err == nil || !strings.HasSuffix(err.Error(), "unexpected end at 20") {
+		vnic.Resources().Logger().Fail(t, "Expected a truncated condition to be located at its end, got ", err)
+		return
+	}
+	err = p.Post(l8pollaris, false)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	host := &l8tpollaris.L8PHost{HostId: "storage1", Configs: map[int32]*l8tpollaris.L8PHostProtocol{
+		int32(l8tpollaris.L8PProtocol_L8PPSNMPV2): {Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2, Addr: "10.0.0.1"}}}
+	ctx := condition.NewVars(&l8tpollaris.L8PTarget{TargetId: "storage1"}, host,
+		map[string]string{"vendor": "netapp", "model": "FAS8200"})
+	polls, err := p.ApplicablePolls(l8pollaris.Name, ctx)
+	if err != nil || len(polls) != 2 || polls[0] != "sysinfo" || polls[1] != "volumes" {
+		vnic.Resources().Logger().Fail(t, "Unexpected applicable polls ", polls)
+		return
+	}
+	ctx.Set("result.model", "Simulator")
+	polls, err = p.ApplicablePolls(l8pollaris.Name, ctx)
+	if err != nil || len(polls) != 1 || polls[0] != "sysinfo" {
+		vnic.Resources().Logger().Fail(t, "Unexpected applicable polls ", polls)
+		return
+	}
+}
+
+// TestPollarisNestedGroups verifies groups that include other groups:
+// 1. Expands the included groups recursively in Names and PollsByGroup
+// 2. Rejects an include that forms a cycle
+// 3. Resolves the membership tree of a group
+// 4. Ignores a group definition from a peer older than the stored one
+func TestPollarisNestedGroups(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	for name, group := range map[string]string{"nested-a": "nested-boot01", "nested-b": "nested-boot02",
+		"nested-c": "nested-interfaces"} {
+		err := p.Post(&l8tpollaris.L8Pollaris{Name: name, Groups: []string{group},
+			Polling: map[string]*l8tpollaris.L8Poll{"sysname": {Name: "sysname", What: ".1.3.6.1.2.1.1.5.0",
+				Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2, Operation: l8tpollaris.L8C_Operation_L8C_Get}}}, false)
+		if err != nil {
+			vnic.Resources().Logger().Fail(t, err.Error())
+			return
+		}
+	}
+	err := p.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "nested-boot",
+		Includes: []string{"nested-boot01", "nested-boot02"}}, false)
+	if err == nil {
+		err = p.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "nested-full",
+			Includes: []string{"nested-boot", "nested-interfaces"}}, false)
+	}
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	names := p.Names("nested-full", "", "", "", "", "", "")
+	sort.Strings(names)
+	if len(names) != 3 || names[0] != "nested-a" || names[2] != "nested-c" {
+		vnic.Resources().Logger().Fail(t, "Unexpected nested group members ", names)
+		return
+	}
+	if len(p.PollsByGroup("nested-boot", "", "", "", "", "", "")) != 2 {
+		vnic.Resources().Logger().Fail(t, "Expected 2 pollarises in the nested boot group")
+		return
+	}
+
+	err = p.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "nested-boot01",
+		Includes: []string{"nested-full"}}, false)
+	if err == nil {
+		vnic.Resources().Logger().Fail(t, "Expected a group cycle to be rejected")
+		return
+	}
+
+	tree := p.GroupTree("nested-full")
+	if len(tree.Members) != 0 || len(tree.Groups) != 2 || tree.Groups[0].Group != "nested-boot" ||
+		len(tree.Groups[0].Groups) != 2 || len(tree.Groups[0].Groups[1].Members) != 1 ||
+		tree.Groups[0].Groups[1].Members[0] != "nested-b" || tree.Groups[1].Members[0] != "nested-c" {
+		vnic.Resources().Logger().Fail(t, "Unexpected group tree ", tree)
+		return
+	}
+
+	stored := p.GroupDefinition("nested-boot")
+	if stored == nil || stored.Modified == 0 || stored.Origin != vnic.Resources().SysConfig().LocalUuid {
+		vnic.Resources().Logger().Fail(t, "Expected the group definition to be stamped")
+		return
+	}
+	err = p.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "nested-boot", Includes: []string{"nested-boot01"},
+		Modified: stored.Modified - 1, Origin: "peer"}, true)
+	if err != nil || len(p.GroupIncludes("nested-boot")) != 2 {
+		vnic.Resources().Logger().Fail(t, "Expected the stale group definition to be ignored")
+		return
+	}
+	err = p.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "nested-boot", Includes: []string{"nested-boot01"},
+		Modified: stored.Modified + 1, Origin: "peer"}, true)
+	if err != nil || len(p.GroupIncludes("nested-boot")) != 1 || p.GroupDefinition("nested-boot").Origin != "peer" {
+		vnic.Resources().Logger().Fail(t, "Expected the newer group definition to be applied")
+		return
+	}
+}
+
+// TestPollarisIntrospection verifies the group and index introspection:
+// 1. Lists the group catalog with the member counts
+// 2. Returns the groups and keys of a pollaris
+// 3. Returns the key table entries of the pollaris
+// 4. Serves the catalog, index and key table on GET with copies of the results
+func TestPollarisIntrospection(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	p := activatePollaris(vnic)
+	polling := map[string]*l8tpollaris.L8Poll{"sysname": {Name: "sysname", What: ".1.3.6.1.2.1.1.5.0",
+		Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2, Operation: l8tpollaris.L8C_Operation_L8C_Get}}
+	err := p.Post(&l8tpollaris.L8Pollaris{Name: "intro-a", Vendor: "cisco",
+		Groups: []string{"intro-g1", "intro-g2"}, Polling: polling}, false)
+	if err == nil {
+		err = p.Post(&l8tpollaris.L8Pollaris{Name: "intro-b", Groups: []string{"intro-g2"}, Polling: polling}, false)
+	}
+	if err == nil {
+		err = p.DefineGroup(&l8tpollaris.L8PollarisGroupDefinition{Group: "intro-all",
+			Includes: []string{"intro-g1", "intro-g2"}}, false)
+	}
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	catalog := map[string]*l8tpollaris.L8PGroupInfo{}
+	for _, info := range p.Groups() {
+		catalog[info.Group] = info
+	}
+	if catalog["intro-g1"] == nil || catalog["intro-g1"].Members != 1 || catalog["intro-g2"] == nil ||
+		catalog["intro-g2"].Members != 2 || catalog["intro-all"] == nil || catalog["intro-all"].Members != 0 ||
+		catalog["intro-all"].Total != 2 || len(catalog["intro-all"].Includes) != 2 {
+		vnic.Resources().Logger().Fail(t, "Unexpected group catalog ", p.Groups())
+		return
+	}
+
+	index := p.Index("intro-a")
+	if len(index) != 1 || len(index[0].Groups) != 2 || index[0].Groups[0] != "intro-g1" ||
+		len(index[0].Keys) != 1 || index[0].Keys[0] != "intro-a+cisco" {
+		vnic.Resources().Logger().Fail(t, "Unexpected index ", index)
+		return
+	}
+
+	found := false
+	for _, entry := range p.Keys() {
+		if entry.Key == "intro-a+cisco" && entry.Name == "intro-a" {
+			found = true
+		}
+	}
+	if !found {
+		vnic.Resources().Logger().Fail(t, "Expected the key of intro-a in the key table")
+		return
+	}
+
+	handler, _ := vnic.Resources().Services().ServiceHandler(pollaris.ServiceName, 0)
+	resp := handler.Get(object.New(nil, &l8tpollaris.L8PollarisGroups{}), vnic)
+	groups, ok := resp.Element().(*l8tpollaris.L8PollarisGroups)
+	if resp.Error() != nil || !ok || len(groups.Groups) == 0 {
+		vnic.Resources().Logger().Fail(t, "Expected the group catalog on GET")
+		return
+	}
+	for _, info := range groups.Groups {
+		info.Includes = append(info.Includes, "modified")
+	}
+	if len(p.GroupIncludes("intro-all")) != 2 {
+		vnic.Resources().Logger().Fail(t, "Expected the group catalog result not to alias the center")
+		return
+	}
+
+	resp = handler.Get(object.New(nil, &l8tpollaris.L8PollarisIndex{Name: "intro-a"}), vnic)
+	indexed, ok := resp.Element().(*l8tpollaris.L8PollarisIndex)
+	if resp.Error() != nil || !ok || len(indexed.Entries) != 1 || !proto.Equal(indexed.Entries[0], index[0]) {
+		vnic.Resources().Logger().Fail(t, "Expected the index of intro-a on GET")
+		return
+	}
+	indexed.Entries[0].Groups[0] = "modified"
+	if p.Index("intro-a")[0].Groups[0] != "intro-g1" {
+		vnic.Resources().Logger().Fail(t, "Expected the index result not to alias the center")
+		return
+	}
+
+	resp = handler.Get(object.New(nil, &l8tpollaris.L8PollarisKeys{}), vnic)
+	keys, ok := resp.Element().(*l8tpollaris.L8PollarisKeys)
+	if resp.Error() != nil || !ok || len(keys.Keys) != len(p.Keys()) {
+		vnic.Resources().Logger().Fail(t, "Expected the key table on GET")
+		return
+	}
+	for _, entry := range keys.Keys {
+		entry.Name = "modified"
+	}
+	found = false
+	for _, entry := range p.Keys() {
+		if entry.Key == "intro-a+cisco" && entry.Name == "intro-a" {
+			found = true
+		}
+	}
+	if !found {
+		vnic.Resources().Logger().Fail(t, "Expected the key table result not to alias the center")
+		return
+	}
+}
+
+// caller is the sender of a request, like the incoming message.
+type caller struct {
+	aaaId  string
+	source string
+}
+
+// AAAId returns the sender identity.
+func (this *caller) AAAId() string {
+	return this.aaaId
+}
+
+// Source returns the sender uuid.
+func (this *caller) Source() string {
+	return this.source
+}
+
+// TestPollarisAuthorization verifies the role based permissions of the service:
+// 1. Denies a write of an identity without roles
+// 2. Allows a write in the group scope of a role and denies it outside of it
+// 3. Allows reads to a read-only identity and denies it deletes
+// 4. Authorizes a notification of a client and trusts one of a Pollaris peer
+// 5. Authorizes the requests sent through the vnic with the message identity
+func TestPollarisAuthorization(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	activatePollaris(vnic)
+	handler, _ := vnic.Resources().Services().ServiceHandler(pollaris.ServiceName, 0)
+	center := pollaris.Pollaris(vnic.Resources())
+
+	authorizer := pollaris.NewRoleAuthorizer()
+	authorizer.AddRole(&pollaris.Role{Name: "editor", Groups: []string{"authz"},
+		Permissions: []pollaris.Permission{pollaris.PermissionRead, pollaris.PermissionCreate, pollaris.PermissionUpdate}})
+	authorizer.AddRole(&pollaris.Role{Name: "reader", Permissions: []pollaris.Permission{pollaris.PermissionRead}})
+	authorizer.Bind("editor-id", "editor")
+	authorizer.Bind("reader-id", "reader")
+	pollaris.PollarisAuthorizer = authorizer
+	defer func() { pollaris.PollarisAuthorizer = nil }()
+
+	polling := func() map[string]*l8tpollaris.L8Poll {
+		return map[string]*l8tpollaris.L8Poll{"sysName": {Name: "sysName", What: ".1.3.6.1.2.1.1.5.0",
+			Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2, Operation: l8tpollaris.L8C_Operation_L8C_Get}}
+	}
+	scoped := &l8tpollaris.L8Pollaris{Name: "authz-scoped", Groups: []string{"authz"}, Polling: polling()}
+	resp := handler.Post(object.New(nil, scoped), vnic)
+	if resp.Error() == nil || !strings.Contains(resp.Error().Error(), "not permitted") {
+		vnic.Resources().Logger().Fail(t, "Expected an anonymous post to be denied")
+		return
+	}
+	if center.Definition(scoped.Name) != nil {
+		vnic.Resources().Logger().Fail(t, "Expected the denied post not to store ", scoped.Name)
+		return
+	}
+	resp = handler.Post(pollaris.WithCaller(object.New(nil, scoped), &caller{aaaId: "editor-id"}), vnic)
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	if center.Definition(scoped.Name) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the allowed post to store ", scoped.Name)
+		return
+	}
+	outside := &l8tpollaris.L8Pollaris{Name: "authz-outside", Groups: []string{"other"}, Polling: polling()}
+	resp = handler.Post(pollaris.WithCaller(object.New(nil, outside), &caller{aaaId: "editor-id"}), vnic)
+	if resp.Error() == nil || !strings.Contains(resp.Error().Error(), "not permitted") {
+		vnic.Resources().Logger().Fail(t, "Expected a post outside of the editor scope to be denied")
+		return
+	}
+	if center.Definition(outside.Name) != nil {
+		vnic.Resources().Logger().Fail(t, "Expected the denied post not to store ", outside.Name)
+		return
+	}
+
+	resp = handler.Get(pollaris.WithCaller(object.New(nil, &l8tpollaris.L8Pollaris{Name: scoped.Name}),
+		&caller{aaaId: "reader-id"}), vnic)
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	resp = handler.Delete(pollaris.WithCaller(object.New(nil, &l8tpollaris.L8Pollaris{Name: scoped.Name}),
+		&caller{aaaId: "reader-id"}), vnic)
+	if resp.Error() == nil || !strings.Contains(resp.Error().Error(), "not permitted") {
+		vnic.Resources().Logger().Fail(t, "Expected a delete of a reader to be denied")
+		return
+	}
+	if center.Definition(scoped.Name) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the denied delete to keep ", scoped.Name)
+		return
+	}
+
+	resp = handler.Delete(pollaris.WithCaller(object.NewNotify(&l8tpollaris.L8Pollaris{Name: scoped.Name}),
+		&caller{aaaId: "reader-id", source: "client-uuid"}), vnic)
+	if resp.Error() == nil || center.Definition(scoped.Name) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected a notification of a client to be authorized")
+		return
+	}
+	pollaris.PollarisPeers = func(uuid string) bool { return uuid == "peer-uuid" }
+	defer func() { pollaris.PollarisPeers = nil }()
+	resp = handler.Delete(pollaris.WithCaller(object.NewNotify(&l8tpollaris.L8Pollaris{Name: scoped.Name}),
+		&caller{source: "peer-uuid"}), vnic)
+	if resp.Error() != nil || center.Definition(scoped.Name) != nil {
+		vnic.Resources().Logger().Fail(t, "Expected a notification of a Pollaris peer to be trusted")
+		return
+	}
+
+	// a request sent over the network is authorized with the identity of
+	// its message, the test topology carrying the token as the identity
+	client := topo.VnicByVnetNum(1, 3)
+	destination := vnic.Resources().SysConfig().LocalUuid
+	resp = client.Request(destination, pollaris.ServiceName, 0, ifs.POST, scoped, 5)
+	if resp.Error() == nil || center.Definition(scoped.Name) != nil {
+		vnic.Resources().Logger().Fail(t, "Expected an anonymous request to be denied")
+		return
+	}
+	resp = client.Request(destination, pollaris.ServiceName, 0, ifs.POST, scoped, 5, "editor-id")
+	if resp.Error() != nil || center.Definition(scoped.Name) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the request of the editor to be allowed")
+		return
+	}
+	resp = client.Request(destination, pollaris.ServiceName, 0, ifs.DELETE,
+		&l8tpollaris.L8Pollaris{Name: scoped.Name}, 5, "editor-id")
+	if resp.Error() == nil || center.Definition(scoped.Name) == nil {
+		vnic.Resources().Logger().Fail(t, "Expected the delete of the editor to be denied")
+		return
+	}
+}
+
+// TestPollarisAudit verifies the audit trail of pollaris changes:
+// 1. Posts, updates and deletes a pollaris with an identified caller
+// 2. Queries the trail of the pollaris through the Audit service
+// 3. Verifies the actor, operations and diff of the records
+// 4. Verifies a change of an anonymous caller is recorded as anonymous
+// 5. Records the changes sent through the vnic with the identity of their message
+// 6. Denies an anonymous query of the trail when callers are authorized
+func TestPollarisAudit(t *testing.T) {
+	vnic := topo.VnicByVnetNum(2, 2)
+	activatePollaris(vnic)
+	handler, _ := vnic.Resources().Services().ServiceHandler(pollaris.ServiceName, 0)
+	store, err := audit.NewFileStore(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	audit.AuditStore = store
+	defer func() { audit.AuditStore = nil }()
+	err = audit.Activate(vnic)
+	if err != nil {
+		vnic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+
+	audited := &l8tpollaris.L8Pollaris{Name: "audited", Groups: []string{"audit"},
+		Polling: map[string]*l8tpollaris.L8Poll{"sysName": {Name: "sysName", What: ".1.3.6.1.2.1.1.5.0",
+			Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2, Operation: l8tpollaris.L8C_Operation_L8C_Get}}}
+	resp := handler.Post(pollaris.WithCaller(object.New(nil, audited), &caller{aaaId: "auditor"}), vnic)
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	updated := proto.Clone(audited).(*l8tpollaris.L8Pollaris)
+	updated.Polling["sysName"].What = ".1.3.6.1.2.1.1.6.0"
+	resp = handler.Put(pollaris.WithCaller(object.New(nil, updated), &caller{aaaId: "auditor"}), vnic)
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	resp = handler.Delete(pollaris.WithCaller(object.New(nil, &l8tpollaris.L8Pollaris{Name: audited.Name}),
+		&caller{aaaId: "auditor"}), vnic)
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+
+	auditHandler, _ := vnic.Resources().Services().ServiceHandler(audit.ServiceName, audit.ServiceArea)
+	resp = auditHandler.Post(object.New(nil, &l8tpollaris.L8PAuditQuery{ObjectType: "L8Pollaris",
+		ObjectId: audited.Name}), vnic)
+	query, ok := resp.Element().(*l8tpollaris.L8PAuditQuery)
+	if resp.Error() != nil || !ok || len(query.Records) != 3 {
+		vnic.Resources().Logger().Fail(t, "Expected 3 audit records of ", audited.Name)
+		return
+	}
+	operations := []string{"POST", "PUT", "DELETE"}
+	for i, record := range query.Records {
+		if record.Actor != "auditor" || record.Operation != operations[i] || len(record.Changes) == 0 {
+			vnic.Resources().Logger().Fail(t, "Unexpected audit record ", record.Operation, " by ", record.Actor)
+			return
+		}
+	}
+	found := false
+	for _, change := range query.Records[1].Changes {
+		if change.Path == "polling[sysName].what" && change.NewValue == ".1.3.6.1.2.1.1.6.0" {
+			found = true
+		}
+	}
+	if !found {
+		vnic.Resources().Logger().Fail(t, "Expected the update diff to contain the changed poll")
+		return
+	}
+
+	anonymous := proto.Clone(audited).(*l8tpollaris.L8Pollaris)
+	anonymous.Name = "audited-anonymously"
+	resp = handler.Post(object.New(nil, anonymous), vnic)
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	resp = auditHandler.Post(object.New(nil, &l8tpollaris.L8PAuditQuery{ObjectType: "L8Pollaris",
+		ObjectId: anonymous.Name}), vnic)
+	query, ok = resp.Element().(*l8tpollaris.L8PAuditQuery)
+	if resp.Error() != nil || !ok || len(query.Records) != 1 || query.Records[0].Actor != audit.Anonymous {
+		vnic.Resources().Logger().Fail(t, "Expected an anonymous audit record of ", anonymous.Name)
+		return
+	}
+
+	// the requests sent through the vnic are audited with the identity of
+	// their message, including the group definitions
+	client := topo.VnicByVnetNum(1, 3)
+	destination := vnic.Resources().SysConfig().LocalUuid
+	messaged := proto.Clone(audited).(*l8tpollaris.L8Pollaris)
+	messaged.Name = "audited-by-message"
+	resp = client.Request(destination, pollaris.ServiceName, 0, ifs.POST, messaged, 5, "messenger")
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	resp = client.Request(destination, pollaris.ServiceName, 0, ifs.POST, &l8tpollaris.L8PollarisGroupDefinition{
+		Group: "audited-all", Includes: []string{"audit"}}, 5, "messenger")
+	if resp.Error() != nil {
+		vnic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	for _, request := range []*l8tpollaris.L8PAuditQuery{{ObjectType: "L8Pollaris", ObjectId: messaged.Name},
+		{ObjectType: "L8PollarisGroupDefinition", ObjectId: "audited-all"}} {
+		resp = auditHandler.Post(object.New(nil, request), vnic)
+		query, ok = resp.Element().(*l8tpollaris.L8PAuditQuery)
+		if resp.Error() != nil || !ok || len(query.Records) != 1 || query.Records[0].Actor != "messenger" {
+			vnic.Resources().Logger().Fail(t, "Expected an audit record of ", request.ObjectId, " by the message identity")
+			return
+		}
+	}
+
+	authorizer := pollaris.NewRoleAuthorizer()
+	authorizer.AddRole(&pollaris.Role{Name: "reader", Permissions: []pollaris.Permission{pollaris.PermissionRead}})
+	authorizer.Bind("auditor", "reader")
+	pollaris.PollarisAuthorizer = authorizer
+	defer func() { pollaris.PollarisAuthorizer = nil }()
+	resp = auditHandler.Post(object.New(nil, &l8tpollaris.L8PAuditQuery{ObjectType: "L8Pollaris"}), vnic)
+	if resp.Error() == nil {
+		vnic.Resources().Logger().Fail(t, "Expected an anonymous audit query to be denied")
+		return
+	}
+	resp = client.Request(destination, audit.ServiceName, audit.ServiceArea, ifs.POST,
+		&l8tpollaris.L8PAuditQuery{ObjectType: "L8Pollaris", Actor: "auditor"}, 5, "auditor")
+	query, ok = resp.Element().(*l8tpollaris.L8PAuditQuery)
+	if resp.Error() != nil || !ok || len(query.Records) != 3 {
+		vnic.Resources().Logger().Fail(t, "Expected the auditor to query its 3 audit records")
+		return
+	}
+}
+
+// waitFor polls the condition until it holds or a few seconds pass.
+// Returns whether the condition holds.
+func waitFor(condition func() bool) bool {
+	for i := 0; i < 300; i++ {
+		if condition() {
+			return true
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	return condition()
+}
+
+// sortedKeys returns the poll names of a polling map, sorted.
+func sortedKeys(polling map[string]*l8tpollaris.L8Poll) []string {
+	names := make([]string, 0, len(polling))
+	for name := range polling {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// contains reports whether the list contains the given string.
+func contains(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -15,11 +15,14 @@ package tests
 
 import (
 	"fmt"
+	"github.com/saichler/l8pollaris/go/pollaris/audit"
 	"github.com/saichler/l8pollaris/go/pollaris/targets"
 	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
 	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
 	"github.com/saichler/probler/go/prob/common"
 	"github.com/saichler/probler/go/prob/common/creates"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -75,3 +78,68 @@ func TestTargetService(t *testing.T) {
 	fmt.Println("Sleeping")
 	time.Sleep(time.Second * 15)
 }
+
+// TestTargetAudit verifies the audit trail of target changes:
+// 1. Activates the Targets service with auditing enabled
+// 2. Posts and patches a new target through the vnic with an identified caller
+// 3. Queries the trail of the target through the Audit service
+// 4. Verifies the actor, operations and diff of the records
+func TestTargetAudit(t *testing.T) {
+	nic := topo.VnicByVnetNum(1, 3)
+	store, err := audit.NewFileStore(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		nic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	audit.AuditStore = store
+	defer func() { audit.AuditStore = nil }()
+	err = audit.Activate(nic)
+	if err != nil {
+		nic.Resources().Logger().Fail(t, err.Error())
+		return
+	}
+	targets.Activate("admin", "admin", nic)
+
+	now := time.Now().UnixNano()
+	ip := "10.30." + strconv.Itoa(int(now/1000%250)+1) + "." + strconv.Itoa(int(now%250)+1)
+	device := creates.CreateDevice(ip, common.NetworkDevice_Links_ID, "audited")
+	device.TargetId = "audited-" + strconv.FormatInt(now, 36)
+	uuid := nic.Resources().SysConfig().LocalUuid
+	resp := nic.Request(uuid, targets.ServiceName, targets.ServiceArea, ifs.POST, device, 5, "operator")
+	if resp.Error() != nil {
+		nic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+	patch := &l8tpollaris.L8PTarget{TargetId: device.TargetId, State: l8tpollaris.L8PTargetState_Up}
+	resp = nic.Request(uuid, targets.ServiceName, targets.ServiceArea, ifs.PATCH, patch, 5, "operator")
+	if resp.Error() != nil {
+		nic.Resources().Logger().Fail(t, resp.Error().Error())
+		return
+	}
+
+	auditHandler, _ := nic.Resources().Services().ServiceHandler(audit.ServiceName, audit.ServiceArea)
+	resp = auditHandler.Post(object.New(nil, &l8tpollaris.L8PAuditQuery{ObjectType: "L8PTarget",
+		ObjectId: device.TargetId, From: now}), nic)
+	query, ok := resp.Element().(*l8tpollaris.L8PAuditQuery)
+	if resp.Error() != nil || !ok || len(query.Records) != 2 {
+		nic.Resources().Logger().Fail(t, "Expected 2 audit records of target ", device.TargetId)
+		return
+	}
+	operations := []string{"POST", "PATCH"}
+	for i, record := range query.Records {
+		if record.Actor != "operator" || record.Operation != operations[i] || len(record.Changes) == 0 {
+			nic.Resources().Logger().Fail(t, "Unexpected audit record ", record.Operation, " by ", record.Actor)
+			return
+		}
+	}
+	found := false
+	for _, change := range query.Records[1].Changes {
+		if change.Path == "state" {
+			found = true
+		}
+	}
+	if !found {
+		nic.Resources().Logger().Fail(t, "Expected the patch diff to contain the state")
+		return
+	}
+}
@@ -24,6 +24,7 @@
 package l8tpollaris
 
 import (
+	l8api "github.com/saichler/l8types/go/types/l8api"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -37,6 +38,242 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// L8PConflictPolicy decides how an imported pollaris that already exists is handled.
+type L8PConflictPolicy int32
+
+const (
+	// L8PConflict_Skip keeps the existing pollaris
+	L8PConflictPolicy_L8PConflict_Skip L8PConflictPolicy = 0
+	// L8PConflict_Overwrite replaces the existing pollaris
+	L8PConflictPolicy_L8PConflict_Overwrite L8PConflictPolicy = 1
+	// L8PConflict_Rename stores the imported pollaris under a new name
+	L8PConflictPolicy_L8PConflict_Rename L8PConflictPolicy = 2
+)
+
+// Enum value maps for L8PConflictPolicy.
+var (
+	L8PConflictPolicy_name = map[int32]string{
+		0: "L8PConflict_Skip",
+		1: "L8PConflict_Overwrite",
+		2: "L8PConflict_Rename",
+	}
+	L8PConflictPolicy_value = map[string]int32{
+		"L8PConflict_Skip":      0,
+		"L8PConflict_Overwrite": 1,
+		"L8PConflict_Rename":    2,
+	}
+)
+
+func (x L8PConflictPolicy) Enum() *L8PConflictPolicy {
+	p := new(L8PConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x L8PConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8PConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_pollaris_proto_enumTypes[0].Descriptor()
+}
+
+func (L8PConflictPolicy) Type() protoreflect.EnumType {
+	return &file_pollaris_proto_enumTypes[0]
+}
+
+func (x L8PConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8PConflictPolicy.Descriptor instead.
+func (L8PConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{0}
+}
+
+// L8PImportStatus is the outcome of importing a single pollaris.
+type L8PImportStatus int32
+
+const (
+	// L8PImport_Invalid is the default/unset value
+	L8PImportStatus_L8PImport_Invalid L8PImportStatus = 0
+	// L8PImport_Added indicates a new pollaris was added
+	L8PImportStatus_L8PImport_Added L8PImportStatus = 1
+	// L8PImport_Skipped indicates a conflicting pollaris was kept as is
+	L8PImportStatus_L8PImport_Skipped L8PImportStatus = 2
+	// L8PImport_Overwritten indicates a conflicting pollaris was replaced
+	L8PImportStatus_L8PImport_Overwritten L8PImportStatus = 3
+	// L8PImport_Renamed indicates the pollaris was stored under a new name
+	L8PImportStatus_L8PImport_Renamed L8PImportStatus = 4
+	// L8PImport_Unchanged indicates an identical pollaris already exists
+	L8PImportStatus_L8PImport_Unchanged L8PImportStatus = 5
+	// L8PImport_Failed indicates the pollaris could not be stored
+	L8PImportStatus_L8PImport_Failed L8PImportStatus = 6
+)
+
+// Enum value maps for L8PImportStatus.
+var (
+	L8PImportStatus_name = map[int32]string{
+		0: "L8PImport_Invalid",
+		1: "L8PImport_Added",
+		2: "L8PImport_Skipped",
+		3: "L8PImport_Overwritten",
+		4: "L8PImport_Renamed",
+		5: "L8PImport_Unchanged",
+		6: "L8PImport_Failed",
+	}
+	L8PImportStatus_value = map[string]int32{
+		"L8PImport_Invalid":     0,
+		"L8PImport_Added":       1,
+		"L8PImport_Skipped":     2,
+		"L8PImport_Overwritten": 3,
+		"L8PImport_Renamed":     4,
+		"L8PImport_Unchanged":   5,
+		"L8PImport_Failed":      6,
+	}
+)
+
+func (x L8PImportStatus) Enum() *L8PImportStatus {
+	p := new(L8PImportStatus)
+	*p = x
+	return p
+}
+
+func (x L8PImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8PImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pollaris_proto_enumTypes[1].Descriptor()
+}
+
+func (L8PImportStatus) Type() protoreflect.EnumType {
+	return &file_pollaris_proto_enumTypes[1]
+}
+
+func (x L8PImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8PImportStatus.Descriptor instead.
+func (L8PImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{1}
+}
+
+// L8PEventType is the kind of change in a L8PollarisEvent.
+type L8PEventType int32
+
+const (
+	// L8PEvent_Invalid is the default/unset value
+	L8PEventType_L8PEvent_Invalid L8PEventType = 0
+	// L8PEvent_Added indicates a new pollaris, or a group nesting
+	L8PEventType_L8PEvent_Added L8PEventType = 1
+	// L8PEvent_Updated indicates a changed pollaris, or group nesting
+	L8PEventType_L8PEvent_Updated L8PEventType = 2
+	// L8PEvent_Removed indicates a deleted pollaris, or group nesting
+	L8PEventType_L8PEvent_Removed L8PEventType = 3
+)
+
+// Enum value maps for L8PEventType.
+var (
+	L8PEventType_name = map[int32]string{
+		0: "L8PEvent_Invalid",
+		1: "L8PEvent_Added",
+		2: "L8PEvent_Updated",
+		3: "L8PEvent_Removed",
+	}
+	L8PEventType_value = map[string]int32{
+		"L8PEvent_Invalid": 0,
+		"L8PEvent_Added":   1,
+		"L8PEvent_Updated": 2,
+		"L8PEvent_Removed": 3,
+	}
+)
+
+func (x L8PEventType) Enum() *L8PEventType {
+	p := new(L8PEventType)
+	*p = x
+	return p
+}
+
+func (x L8PEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8PEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pollaris_proto_enumTypes[2].Descriptor()
+}
+
+func (L8PEventType) Type() protoreflect.EnumType {
+	return &file_pollaris_proto_enumTypes[2]
+}
+
+func (x L8PEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8PEventType.Descriptor instead.
+func (L8PEventType) EnumDescriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{2}
+}
+
+// L8PChangeType is the kind of a L8PChange.
+type L8PChangeType int32
+
+const (
+	// L8PChange_Invalid is the default/unset value
+	L8PChangeType_L8PChange_Invalid L8PChangeType = 0
+	// L8PChange_Added indicates a value that was added
+	L8PChangeType_L8PChange_Added L8PChangeType = 1
+	// L8PChange_Removed indicates a value that was removed
+	L8PChangeType_L8PChange_Removed L8PChangeType = 2
+	// L8PChange_Changed indicates a value that was changed
+	L8PChangeType_L8PChange_Changed L8PChangeType = 3
+)
+
+// Enum value maps for L8PChangeType.
+var (
+	L8PChangeType_name = map[int32]string{
+		0: "L8PChange_Invalid",
+		1: "L8PChange_Added",
+		2: "L8PChange_Removed",
+		3: "L8PChange_Changed",
+	}
+	L8PChangeType_value = map[string]int32{
+		"L8PChange_Invalid": 0,
+		"L8PChange_Added":   1,
+		"L8PChange_Removed": 2,
+		"L8PChange_Changed": 3,
+	}
+)
+
+func (x L8PChangeType) Enum() *L8PChangeType {
+	p := new(L8PChangeType)
+	*p = x
+	return p
+}
+
+func (x L8PChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8PChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pollaris_proto_enumTypes[3].Descriptor()
+}
+
+func (L8PChangeType) Type() protoreflect.EnumType {
+	return &file_pollaris_proto_enumTypes[3]
+}
+
+func (x L8PChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8PChangeType.Descriptor instead.
+func (L8PChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{3}
+}
+
 // L8C_Operation defines the type of data collection operation.
 type L8C_Operation int32
 
@@ -78,11 +315,11 @@ func (x L8C_Operation) String() string {
 }
 
 func (L8C_Operation) Descriptor() protoreflect.EnumDescriptor {
-	return file_pollaris_proto_enumTypes[0].Descriptor()
+	return file_pollaris_proto_enumTypes[4].Descriptor()
 }
 
 func (L8C_Operation) Type() protoreflect.EnumType {
-	return &file_pollaris_proto_enumTypes[0]
+	return &file_pollaris_proto_enumTypes[4]
 }
 
 func (x L8C_Operation) Number() protoreflect.EnumNumber {
@@ -91,7 +328,7 @@ func (x L8C_Operation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use L8C_Operation.Descriptor instead.
 func (L8C_Operation) EnumDescriptor() ([]byte, []int) {
-	return file_pollaris_proto_rawDescGZIP(), []int{0}
+	return file_pollaris_proto_rawDescGZIP(), []int{4}
 }
 
 // L8PProtocol defines the supported collection protocols.
@@ -155,11 +392,11 @@ func (x L8PProtocol) String() string {
 }
 
 func (L8PProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_pollaris_proto_enumTypes[1].Descriptor()
+	return file_pollaris_proto_enumTypes[5].Descriptor()
 }
 
 func (L8PProtocol) Type() protoreflect.EnumType {
-	return &file_pollaris_proto_enumTypes[1]
+	return &file_pollaris_proto_enumTypes[5]
 }
 
 func (x L8PProtocol) Number() protoreflect.EnumNumber {
@@ -168,7 +405,7 @@ func (x L8PProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use L8PProtocol.Descriptor instead.
 func (L8PProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_pollaris_proto_rawDescGZIP(), []int{1}
+	return file_pollaris_proto_rawDescGZIP(), []int{5}
 }
 
 // L8Pollaris represents a polling configuration for a specific device type.
@@ -197,6 +434,25 @@ type L8Pollaris struct {
 	Groups []string `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups,omitempty"`
 	// polling maps job names to their polling configurations
 	Polling map[string]*L8Poll `protobuf:"bytes,9,rep,name=polling,proto3" json:"polling,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// remove_polls lists poll names to remove when this pollaris is sent as a patch
+	RemovePolls []string `protobuf:"bytes,10,rep,name=remove_polls,json=removePolls,proto3" json:"remove_polls,omitempty"`
+	// remove_groups lists group names to remove when this pollaris is sent as a patch
+	RemoveGroups []string `protobuf:"bytes,11,rep,name=remove_groups,json=removeGroups,proto3" json:"remove_groups,omitempty"`
+	// revision is the monotonically increasing revision of this configuration
+	Revision int64 `protobuf:"varint,12,opt,name=revision,proto3" json:"revision,omitempty"`
+	// extends names a base configuration whose polls are inherited; polls
+	// declared here add to or override the inherited ones
+	Extends string `protobuf:"bytes,13,opt,name=extends,proto3" json:"extends,omitempty"`
+	// excludes lists inherited poll names that this configuration removes
+	Excludes []string `protobuf:"bytes,14,rep,name=excludes,proto3" json:"excludes,omitempty"`
+	// modified is the time of the last change in unix nanoseconds, used to
+	// resolve conflicting changes with the same revision
+	Modified int64 `protobuf:"varint,15,opt,name=modified,proto3" json:"modified,omitempty"`
+	// origin is the uuid of the instance that made the last change
+	Origin string `protobuf:"bytes,16,opt,name=origin,proto3" json:"origin,omitempty"`
+	// deleted marks the tombstone persisted for a deleted configuration, so
+	// that a provider model with the same name is not restored on restart
+	Deleted bool `protobuf:"varint,17,opt,name=deleted,proto3" json:"deleted,omitempty"`
 }
 
 func (x *L8Pollaris) Reset() {
@@ -294,166 +550,92 @@ func (x *L8Pollaris) GetPolling() map[string]*L8Poll {
 	return nil
 }
 
-// L8Poll defines a single polling job configuration.
-// It specifies what data to collect, how to collect it, and how often.
-type L8Poll struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// name is the identifier for this polling job
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// what specifies the data to collect (e.g., OID for SNMP, command for SSH)
-	What string `protobuf:"bytes,2,opt,name=what,proto3" json:"what,omitempty"`
-	// operation defines the type of collection (Get, Map, Table)
-	Operation L8C_Operation `protobuf:"varint,3,opt,name=operation,proto3,enum=l8tpollaris.L8C_Operation" json:"operation,omitempty"`
-	// protocol specifies which protocol to use for collection
-	Protocol L8PProtocol `protobuf:"varint,4,opt,name=protocol,proto3,enum=l8tpollaris.L8PProtocol" json:"protocol,omitempty"`
-	// cadence defines the polling schedule
-	Cadence *L8PCadencePlan `protobuf:"bytes,5,opt,name=cadence,proto3" json:"cadence,omitempty"`
-	// timeout is the maximum wait time in milliseconds
-	Timeout int64 `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	// attributes define parsing rules for the collected data
-	Attributes []*L8PAttribute `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty"`
-	// bodyName specifies the request body template name
-	BodyName string `protobuf:"bytes,8,opt,name=bodyName,proto3" json:"bodyName,omitempty"`
-	// respName specifies the response parser name
-	RespName string `protobuf:"bytes,9,opt,name=respName,proto3" json:"respName,omitempty"`
-	// always indicates if this job should run on every collection cycle
-	Always bool `protobuf:"varint,10,opt,name=always,proto3" json:"always,omitempty"`
-}
-
-func (x *L8Poll) Reset() {
-	*x = L8Poll{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pollaris_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *L8Poll) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*L8Poll) ProtoMessage() {}
-
-func (x *L8Poll) ProtoReflect() protoreflect.Message {
-	mi := &file_pollaris_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use L8Poll.ProtoReflect.Descriptor instead.
-func (*L8Poll) Descriptor() ([]byte, []int) {
-	return file_pollaris_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *L8Poll) GetName() string {
+func (x *L8Pollaris) GetRemovePolls() []string {
 	if x != nil {
-		return x.Name
+		return x.RemovePolls
 	}
-	return ""
+	return nil
 }
 
-func (x *L8Poll) GetWhat() string {
+func (x *L8Pollaris) GetRemoveGroups() []string {
 	if x != nil {
-		return x.What
+		return x.RemoveGroups
 	}
-	return ""
+	return nil
 }
 
-func (x *L8Poll) GetOperation() L8C_Operation {
+func (x *L8Pollaris) GetRevision() int64 {
 	if x != nil {
-		return x.Operation
+		return x.Revision
 	}
-	return L8C_Operation_Invalid_Operation
+	return 0
 }
 
-func (x *L8Poll) GetProtocol() L8PProtocol {
+func (x *L8Pollaris) GetExtends() string {
 	if x != nil {
-		return x.Protocol
+		return x.Extends
 	}
-	return L8PProtocol_L8PInvalid_Protocol
+	return ""
 }
 
-func (x *L8Poll) GetCadence() *L8PCadencePlan {
+func (x *L8Pollaris) GetExcludes() []string {
 	if x != nil {
-		return x.Cadence
+		return x.Excludes
 	}
 	return nil
 }
 
-func (x *L8Poll) GetTimeout() int64 {
+func (x *L8Pollaris) GetModified() int64 {
 	if x != nil {
-		return x.Timeout
+		return x.Modified
 	}
 	return 0
 }
 
-func (x *L8Poll) GetAttributes() []*L8PAttribute {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
-}
-
-func (x *L8Poll) GetBodyName() string {
-	if x != nil {
-		return x.BodyName
-	}
-	return ""
-}
-
-func (x *L8Poll) GetRespName() string {
+func (x *L8Pollaris) GetOrigin() string {
 	if x != nil {
-		return x.RespName
+		return x.Origin
 	}
 	return ""
 }
 
-func (x *L8Poll) GetAlways() bool {
+func (x *L8Pollaris) GetDeleted() bool {
 	if x != nil {
-		return x.Always
+		return x.Deleted
 	}
 	return false
 }
 
-// L8PAttribute defines a parsed attribute from collected data.
-type L8PAttribute struct {
+// L8PollarisSnapshot carries the full set of pollaris definitions of an
+// instance. As a request it is empty, and the response carries the list.
+type L8PollarisSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// property_id identifies the property this attribute maps to
-	PropertyId string `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
-	// rules define parsing/transformation rules for this attribute
-	Rules []*L8PRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	// list contains the pollaris definitions
+	List []*L8Pollaris `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// groups contains the group definitions
+	Groups []*L8PollarisGroupDefinition `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *L8PAttribute) Reset() {
-	*x = L8PAttribute{}
+func (x *L8PollarisSnapshot) Reset() {
+	*x = L8PollarisSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pollaris_proto_msgTypes[2]
+		mi := &file_pollaris_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *L8PAttribute) String() string {
+func (x *L8PollarisSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8PAttribute) ProtoMessage() {}
+func (*L8PollarisSnapshot) ProtoMessage() {}
 
-func (x *L8PAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_pollaris_proto_msgTypes[2]
+func (x *L8PollarisSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,54 +646,55 @@ func (x *L8PAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use L8PAttribute.ProtoReflect.Descriptor instead.
-func (*L8PAttribute) Descriptor() ([]byte, []int) {
-	return file_pollaris_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use L8PollarisSnapshot.ProtoReflect.Descriptor instead.
+func (*L8PollarisSnapshot) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *L8PAttribute) GetPropertyId() string {
+func (x *L8PollarisSnapshot) GetList() []*L8Pollaris {
 	if x != nil {
-		return x.PropertyId
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-func (x *L8PAttribute) GetRules() []*L8PRule {
+func (x *L8PollarisSnapshot) GetGroups() []*L8PollarisGroupDefinition {
 	if x != nil {
-		return x.Rules
+		return x.Groups
 	}
 	return nil
 }
 
-// L8PRule defines a parsing or transformation rule.
-type L8PRule struct {
+// L8PollarisRevisions lists the retained revisions of a polling configuration.
+// As a request, only name is set and the response carries the revisions.
+type L8PollarisRevisions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name identifies the rule type
+	// name is the pollaris name the revisions belong to
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// params contains rule-specific parameters
-	Params map[string]*L8PParameter `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// revisions contains the retained revisions, oldest first
+	Revisions []*L8Pollaris `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
 }
 
-func (x *L8PRule) Reset() {
-	*x = L8PRule{}
+func (x *L8PollarisRevisions) Reset() {
+	*x = L8PollarisRevisions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pollaris_proto_msgTypes[3]
+		mi := &file_pollaris_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *L8PRule) String() string {
+func (x *L8PollarisRevisions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8PRule) ProtoMessage() {}
+func (*L8PollarisRevisions) ProtoMessage() {}
 
-func (x *L8PRule) ProtoReflect() protoreflect.Message {
-	mi := &file_pollaris_proto_msgTypes[3]
+func (x *L8PollarisRevisions) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,39 +705,98 @@ func (x *L8PRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use L8PRule.ProtoReflect.Descriptor instead.
-func (*L8PRule) Descriptor() ([]byte, []int) {
-	return file_pollaris_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use L8PollarisRevisions.ProtoReflect.Descriptor instead.
+func (*L8PollarisRevisions) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *L8PRule) GetName() string {
+func (x *L8PollarisRevisions) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *L8PRule) GetParams() map[string]*L8PParameter {
+func (x *L8PollarisRevisions) GetRevisions() []*L8Pollaris {
 	if x != nil {
-		return x.Params
+		return x.Revisions
 	}
 	return nil
 }
 
-// L8PParameter represents a named parameter with a string value.
-type L8PParameter struct {
+// L8PollarisRollback requests restoring a polling configuration to a previous revision.
+type L8PollarisRollback struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name is the parameter identifier
+	// name is the pollaris name to roll back
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// value is the parameter value
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// revision is the revision to restore
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *L8PParameter) Reset() {
-	*x = L8PParameter{}
+func (x *L8PollarisRollback) Reset() {
+	*x = L8PollarisRollback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisRollback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisRollback) ProtoMessage() {}
+
+func (x *L8PollarisRollback) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisRollback.ProtoReflect.Descriptor instead.
+func (*L8PollarisRollback) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *L8PollarisRollback) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PollarisRollback) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// L8PollarisList contains a list of polling configurations with optional metadata.
+// Used for query responses.
+type L8PollarisList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list contains the pollaris objects
+	List []*L8Pollaris `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// metadata contains pagination and query information
+	Metadata *l8api.L8MetaData `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *L8PollarisList) Reset() {
+	*x = L8PollarisList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pollaris_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,13 +804,13 @@ func (x *L8PParameter) Reset() {
 	}
 }
 
-func (x *L8PParameter) String() string {
+func (x *L8PollarisList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8PParameter) ProtoMessage() {}
+func (*L8PollarisList) ProtoMessage() {}
 
-func (x *L8PParameter) ProtoReflect() protoreflect.Message {
+func (x *L8PollarisList) ProtoReflect() protoreflect.Message {
 	mi := &file_pollaris_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -580,44 +822,59 @@ func (x *L8PParameter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use L8PParameter.ProtoReflect.Descriptor instead.
-func (*L8PParameter) Descriptor() ([]byte, []int) {
+// Deprecated: Use L8PollarisList.ProtoReflect.Descriptor instead.
+func (*L8PollarisList) Descriptor() ([]byte, []int) {
 	return file_pollaris_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *L8PParameter) GetName() string {
+func (x *L8PollarisList) GetList() []*L8Pollaris {
 	if x != nil {
-		return x.Name
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-func (x *L8PParameter) GetValue() string {
+func (x *L8PollarisList) GetMetadata() *l8api.L8MetaData {
 	if x != nil {
-		return x.Value
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-// L8PCadencePlan defines the polling schedule with adaptive cadences.
-// It supports multiple cadence levels that can be used based on conditions.
-type L8PCadencePlan struct {
+// L8PollarisExplain explains how a pollaris key lookup is resolved.
+// As a request, name and the device attributes are set; the response also
+// carries the resolution trace and the chosen pollaris.
+type L8PollarisExplain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// cadences is a list of polling intervals in milliseconds
-	Cadences []int64 `protobuf:"varint,1,rep,packed,name=cadences,proto3" json:"cadences,omitempty"`
-	// startups defines initial delays before polling starts
-	Startups []int64 `protobuf:"varint,2,rep,packed,name=startups,proto3" json:"startups,omitempty"`
-	// current is the index of the currently active cadence
-	Current int32 `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
-	// enabled indicates if this cadence plan is active
-	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// name is the pollaris name to look up
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// vendor is the device manufacturer
+	Vendor string `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// series is the device product series
+	Series string `protobuf:"bytes,3,opt,name=series,proto3" json:"series,omitempty"`
+	// family is the device product family
+	Family string `protobuf:"bytes,4,opt,name=family,proto3" json:"family,omitempty"`
+	// software is the device software/OS type
+	Software string `protobuf:"bytes,5,opt,name=software,proto3" json:"software,omitempty"`
+	// hardware is the device hardware model
+	Hardware string `protobuf:"bytes,6,opt,name=hardware,proto3" json:"hardware,omitempty"`
+	// version is the device software version
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// tried_keys lists the composite keys looked up, in order
+	TriedKeys []string `protobuf:"bytes,8,rep,name=tried_keys,json=triedKeys,proto3" json:"tried_keys,omitempty"`
+	// matched_key is the key of the chosen pollaris, empty if none matched
+	MatchedKey string `protobuf:"bytes,9,opt,name=matched_key,json=matchedKey,proto3" json:"matched_key,omitempty"`
+	// candidates lists the pollarises considered after the exact key lookup
+	Candidates []*L8PExplainCandidate `protobuf:"bytes,10,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// result is the chosen effective pollaris
+	Result *L8Pollaris `protobuf:"bytes,11,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *L8PCadencePlan) Reset() {
-	*x = L8PCadencePlan{}
+func (x *L8PollarisExplain) Reset() {
+	*x = L8PollarisExplain{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pollaris_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -625,13 +882,13 @@ func (x *L8PCadencePlan) Reset() {
 	}
 }
 
-func (x *L8PCadencePlan) String() string {
+func (x *L8PollarisExplain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8PCadencePlan) ProtoMessage() {}
+func (*L8PollarisExplain) ProtoMessage() {}
 
-func (x *L8PCadencePlan) ProtoReflect() protoreflect.Message {
+func (x *L8PollarisExplain) ProtoReflect() protoreflect.Message {
 	mi := &file_pollaris_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,214 +900,3620 @@ func (x *L8PCadencePlan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use L8PCadencePlan.ProtoReflect.Descriptor instead.
-func (*L8PCadencePlan) Descriptor() ([]byte, []int) {
+// Deprecated: Use L8PollarisExplain.ProtoReflect.Descriptor instead.
+func (*L8PollarisExplain) Descriptor() ([]byte, []int) {
 	return file_pollaris_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *L8PCadencePlan) GetCadences() []int64 {
+func (x *L8PollarisExplain) GetName() string {
 	if x != nil {
-		return x.Cadences
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PollarisExplain) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *L8PollarisExplain) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *L8PollarisExplain) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *L8PollarisExplain) GetSoftware() string {
+	if x != nil {
+		return x.Software
+	}
+	return ""
+}
+
+func (x *L8PollarisExplain) GetHardware() string {
+	if x != nil {
+		return x.Hardware
+	}
+	return ""
+}
+
+func (x *L8PollarisExplain) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *L8PollarisExplain) GetTriedKeys() []string {
+	if x != nil {
+		return x.TriedKeys
 	}
 	return nil
 }
 
-func (x *L8PCadencePlan) GetStartups() []int64 {
+func (x *L8PollarisExplain) GetMatchedKey() string {
 	if x != nil {
-		return x.Startups
+		return x.MatchedKey
+	}
+	return ""
+}
+
+func (x *L8PollarisExplain) GetCandidates() []*L8PExplainCandidate {
+	if x != nil {
+		return x.Candidates
 	}
 	return nil
 }
 
-func (x *L8PCadencePlan) GetCurrent() int32 {
+func (x *L8PollarisExplain) GetResult() *L8Pollaris {
 	if x != nil {
-		return x.Current
+		return x.Result
 	}
-	return 0
+	return nil
 }
 
-func (x *L8PCadencePlan) GetEnabled() bool {
+// L8PExplainCandidate describes a pollaris considered during a key lookup.
+type L8PExplainCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the candidate pollaris name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// key is the composite key of the candidate
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// chosen indicates this candidate was selected
+	Chosen bool `protobuf:"varint,3,opt,name=chosen,proto3" json:"chosen,omitempty"`
+	// reason explains why the candidate was chosen or skipped
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *L8PExplainCandidate) Reset() {
+	*x = L8PExplainCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PExplainCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PExplainCandidate) ProtoMessage() {}
+
+func (x *L8PExplainCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PExplainCandidate.ProtoReflect.Descriptor instead.
+func (*L8PExplainCandidate) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *L8PExplainCandidate) GetName() string {
 	if x != nil {
-		return x.Enabled
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PExplainCandidate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *L8PExplainCandidate) GetChosen() bool {
+	if x != nil {
+		return x.Chosen
 	}
 	return false
 }
 
-var File_pollaris_proto protoreflect.FileDescriptor
+func (x *L8PExplainCandidate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
 
-var file_pollaris_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x0b, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x22, 0xe3, 0x02,
-	0x0a, 0x0a, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x66, 0x74,
-	0x77, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x66, 0x74,
-	0x77, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
-	0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x50, 0x6f, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x6f, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x1a, 0x4f, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
-	0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xfc, 0x02, 0x0a, 0x06, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x77, 0x68, 0x61, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x70,
-	0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x43, 0x5f, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73,
-	0x2e, 0x4c, 0x38, 0x50, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c,
-	0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x43, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65,
-	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x38,
-	0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6c,
-	0x77, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x6c, 0x77, 0x61,
-	0x79, 0x73, 0x22, 0x5b, 0x0a, 0x0c, 0x4c, 0x38, 0x50, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x79, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73,
-	0x2e, 0x4c, 0x38, 0x50, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22,
-	0xad, 0x01, 0x0a, 0x07, 0x4c, 0x38, 0x50, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38,
-	0x50, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x38, 0x74, 0x70,
-	0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x38, 0x0a, 0x0c, 0x4c, 0x38, 0x50, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x4c, 0x38, 0x50,
-	0x43, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x63,
-	0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x75, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x2a, 0x4f, 0x0a, 0x0d, 0x4c, 0x38, 0x43, 0x5f, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x5f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x4c, 0x38, 0x43, 0x5f, 0x47, 0x65, 0x74, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
-	0x4c, 0x38, 0x43, 0x5f, 0x4d, 0x61, 0x70, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x38, 0x43,
-	0x5f, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x03, 0x2a, 0x9f, 0x01, 0x0a, 0x0b, 0x4c, 0x38, 0x50,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x38, 0x50, 0x49,
-	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x38, 0x50, 0x53, 0x53, 0x48, 0x10, 0x01, 0x12, 0x0e, 0x0a,
-	0x0a, 0x4c, 0x38, 0x50, 0x50, 0x53, 0x4e, 0x4d, 0x50, 0x56, 0x32, 0x10, 0x02, 0x12, 0x0d, 0x0a,
-	0x09, 0x4c, 0x38, 0x50, 0x53, 0x4e, 0x4d, 0x50, 0x56, 0x33, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b,
-	0x4c, 0x38, 0x50, 0x52, 0x45, 0x53, 0x54, 0x43, 0x4f, 0x4e, 0x46, 0x10, 0x04, 0x12, 0x0e, 0x0a,
-	0x0a, 0x4c, 0x38, 0x50, 0x4e, 0x45, 0x54, 0x43, 0x4f, 0x4e, 0x46, 0x10, 0x05, 0x12, 0x0b, 0x0a,
-	0x07, 0x4c, 0x38, 0x50, 0x47, 0x52, 0x50, 0x43, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x38,
-	0x50, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x74, 0x6c, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x38,
-	0x50, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x10, 0x08, 0x42, 0x3b, 0x0a, 0x15, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x42, 0x0b, 0x4c, 0x38, 0x54, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73,
-	0x50, 0x01, 0x5a, 0x13, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6c, 0x38, 0x74, 0x70,
-	0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// L8PollarisBundle is a portable, versioned export of pollaris definitions,
+// used for backups and for moving models between environments.
+type L8PollarisBundle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version is the bundle format version
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// created is the export time in unix seconds
+	Created int64 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// list contains the exported pollaris definitions
+	List []*L8Pollaris `protobuf:"bytes,3,rep,name=list,proto3" json:"list,omitempty"`
+	// groups contains the exported group definitions
+	Groups []*L8PollarisGroupDefinition `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-var (
-	file_pollaris_proto_rawDescOnce sync.Once
-	file_pollaris_proto_rawDescData = file_pollaris_proto_rawDesc
-)
+func (x *L8PollarisBundle) Reset() {
+	*x = L8PollarisBundle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_pollaris_proto_rawDescGZIP() []byte {
-	file_pollaris_proto_rawDescOnce.Do(func() {
-		file_pollaris_proto_rawDescData = protoimpl.X.CompressGZIP(file_pollaris_proto_rawDescData)
-	})
-	return file_pollaris_proto_rawDescData
+func (x *L8PollarisBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_pollaris_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pollaris_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_pollaris_proto_goTypes = []interface{}{
-	(L8C_Operation)(0),     // 0: l8tpollaris.L8C_Operation
-	(L8PProtocol)(0),       // 1: l8tpollaris.L8PProtocol
-	(*L8Pollaris)(nil),     // 2: l8tpollaris.L8Pollaris
-	(*L8Poll)(nil),         // 3: l8tpollaris.L8Poll
-	(*L8PAttribute)(nil),   // 4: l8tpollaris.L8PAttribute
-	(*L8PRule)(nil),        // 5: l8tpollaris.L8PRule
-	(*L8PParameter)(nil),   // 6: l8tpollaris.L8PParameter
-	(*L8PCadencePlan)(nil), // 7: l8tpollaris.L8PCadencePlan
-	nil,                    // 8: l8tpollaris.L8Pollaris.PollingEntry
-	nil,                    // 9: l8tpollaris.L8PRule.ParamsEntry
+func (*L8PollarisBundle) ProtoMessage() {}
+
+func (x *L8PollarisBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_pollaris_proto_depIdxs = []int32{
-	8, // 0: l8tpollaris.L8Pollaris.polling:type_name -> l8tpollaris.L8Pollaris.PollingEntry
-	0, // 1: l8tpollaris.L8Poll.operation:type_name -> l8tpollaris.L8C_Operation
-	1, // 2: l8tpollaris.L8Poll.protocol:type_name -> l8tpollaris.L8PProtocol
-	7, // 3: l8tpollaris.L8Poll.cadence:type_name -> l8tpollaris.L8PCadencePlan
-	4, // 4: l8tpollaris.L8Poll.attributes:type_name -> l8tpollaris.L8PAttribute
-	5, // 5: l8tpollaris.L8PAttribute.rules:type_name -> l8tpollaris.L8PRule
-	9, // 6: l8tpollaris.L8PRule.params:type_name -> l8tpollaris.L8PRule.ParamsEntry
-	3, // 7: l8tpollaris.L8Pollaris.PollingEntry.value:type_name -> l8tpollaris.L8Poll
-	6, // 8: l8tpollaris.L8PRule.ParamsEntry.value:type_name -> l8tpollaris.L8PParameter
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+
+// Deprecated: Use L8PollarisBundle.ProtoReflect.Descriptor instead.
+func (*L8PollarisBundle) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{7}
 }
 
-func init() { file_pollaris_proto_init() }
-func file_pollaris_proto_init() {
-	if File_pollaris_proto != nil {
-		return
+func (x *L8PollarisBundle) GetVersion() int32 {
+	if x != nil {
+		return x.Version
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pollaris_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*L8Pollaris); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pollaris_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*L8Poll); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
+	return 0
+}
+
+func (x *L8PollarisBundle) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *L8PollarisBundle) GetList() []*L8Pollaris {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *L8PollarisBundle) GetGroups() []*L8PollarisGroupDefinition {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// L8PollarisExport requests an export bundle of the pollaris definitions,
+// optionally filtered by group and vendor. The response carries the bundle.
+type L8PollarisExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// groups limits the export to pollarises in any of these groups
+	Groups []string `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// vendors limits the export to pollarises of any of these vendors
+	Vendors []string `protobuf:"bytes,2,rep,name=vendors,proto3" json:"vendors,omitempty"`
+	// bundle is the exported bundle
+	Bundle *L8PollarisBundle `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+}
+
+func (x *L8PollarisExport) Reset() {
+	*x = L8PollarisExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisExport) ProtoMessage() {}
+
+func (x *L8PollarisExport) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisExport.ProtoReflect.Descriptor instead.
+func (*L8PollarisExport) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *L8PollarisExport) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *L8PollarisExport) GetVendors() []string {
+	if x != nil {
+		return x.Vendors
+	}
+	return nil
+}
+
+func (x *L8PollarisExport) GetBundle() *L8PollarisBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+// L8PollarisImport imports a bundle using the given conflict policy.
+// The response carries the result of importing each pollaris.
+type L8PollarisImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bundle is the bundle to import
+	Bundle *L8PollarisBundle `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// policy decides what to do when a pollaris with the same name exists
+	Policy L8PConflictPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=l8tpollaris.L8PConflictPolicy" json:"policy,omitempty"`
+	// results lists the outcome for each pollaris in the bundle
+	Results []*L8PImportResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *L8PollarisImport) Reset() {
+	*x = L8PollarisImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisImport) ProtoMessage() {}
+
+func (x *L8PollarisImport) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisImport.ProtoReflect.Descriptor instead.
+func (*L8PollarisImport) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *L8PollarisImport) GetBundle() *L8PollarisBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *L8PollarisImport) GetPolicy() L8PConflictPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return L8PConflictPolicy_L8PConflict_Skip
+}
+
+func (x *L8PollarisImport) GetResults() []*L8PImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// L8PImportResult is the outcome of importing a single pollaris.
+type L8PImportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the pollaris name in the bundle
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// stored_as is the name the pollaris was stored under
+	StoredAs string `protobuf:"bytes,2,opt,name=stored_as,json=storedAs,proto3" json:"stored_as,omitempty"`
+	// status is what happened to the pollaris
+	Status L8PImportStatus `protobuf:"varint,3,opt,name=status,proto3,enum=l8tpollaris.L8PImportStatus" json:"status,omitempty"`
+	// message describes a conflict or an error
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// group is set, instead of name, for the result of a group definition
+	Group string `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *L8PImportResult) Reset() {
+	*x = L8PImportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PImportResult) ProtoMessage() {}
+
+func (x *L8PImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PImportResult.ProtoReflect.Descriptor instead.
+func (*L8PImportResult) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *L8PImportResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PImportResult) GetStoredAs() string {
+	if x != nil {
+		return x.StoredAs
+	}
+	return ""
+}
+
+func (x *L8PImportResult) GetStatus() L8PImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return L8PImportStatus_L8PImport_Invalid
+}
+
+func (x *L8PImportResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *L8PImportResult) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// L8PollarisSubscription subscribes a service to the pollaris change events,
+// which are posted to it as L8PollarisEvent. Empty names and groups subscribe
+// to all the pollarises.
+type L8PollarisSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// service_name is the name of the subscribed service
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// service_area is the area of the subscribed service
+	ServiceArea int32 `protobuf:"varint,2,opt,name=service_area,json=serviceArea,proto3" json:"service_area,omitempty"`
+	// names limits the events to these pollaris names
+	Names []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	// groups limits the events to pollarises in these groups
+	Groups []string `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	// unsubscribe removes the subscription of the service instead
+	Unsubscribe bool `protobuf:"varint,5,opt,name=unsubscribe,proto3" json:"unsubscribe,omitempty"`
+}
+
+func (x *L8PollarisSubscription) Reset() {
+	*x = L8PollarisSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisSubscription) ProtoMessage() {}
+
+func (x *L8PollarisSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisSubscription.ProtoReflect.Descriptor instead.
+func (*L8PollarisSubscription) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *L8PollarisSubscription) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *L8PollarisSubscription) GetServiceArea() int32 {
+	if x != nil {
+		return x.ServiceArea
+	}
+	return 0
+}
+
+func (x *L8PollarisSubscription) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *L8PollarisSubscription) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *L8PollarisSubscription) GetUnsubscribe() bool {
+	if x != nil {
+		return x.Unsubscribe
+	}
+	return false
+}
+
+// L8PollarisEvent describes a change to a pollaris definition, or to a group
+// definition, in which case group is set instead of name.
+type L8PollarisEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the kind of change
+	Type L8PEventType `protobuf:"varint,1,opt,name=type,proto3,enum=l8tpollaris.L8PEventType" json:"type,omitempty"`
+	// name is the changed pollaris name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// old is the definition before the change, unset when added
+	Old *L8Pollaris `protobuf:"bytes,3,opt,name=old,proto3" json:"old,omitempty"`
+	// new is the definition after the change, unset when removed
+	New *L8Pollaris `protobuf:"bytes,4,opt,name=new,proto3" json:"new,omitempty"`
+	// affected lists the pollarises extending the changed one, whose
+	// effective definitions changed as well, or for a group change the
+	// pollarises in the group, through its includes, before or after it
+	Affected []string `protobuf:"bytes,5,rep,name=affected,proto3" json:"affected,omitempty"`
+	// group is the group whose definition changed
+	Group string `protobuf:"bytes,6,opt,name=group,proto3" json:"group,omitempty"`
+	// old_group is the group definition before the change, unset when added
+	OldGroup *L8PollarisGroupDefinition `protobuf:"bytes,7,opt,name=old_group,json=oldGroup,proto3" json:"old_group,omitempty"`
+	// new_group is the group definition after the change, unset when removed
+	NewGroup *L8PollarisGroupDefinition `protobuf:"bytes,8,opt,name=new_group,json=newGroup,proto3" json:"new_group,omitempty"`
+}
+
+func (x *L8PollarisEvent) Reset() {
+	*x = L8PollarisEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisEvent) ProtoMessage() {}
+
+func (x *L8PollarisEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisEvent.ProtoReflect.Descriptor instead.
+func (*L8PollarisEvent) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *L8PollarisEvent) GetType() L8PEventType {
+	if x != nil {
+		return x.Type
+	}
+	return L8PEventType_L8PEvent_Invalid
+}
+
+func (x *L8PollarisEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PollarisEvent) GetOld() *L8Pollaris {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+func (x *L8PollarisEvent) GetNew() *L8Pollaris {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+func (x *L8PollarisEvent) GetAffected() []string {
+	if x != nil {
+		return x.Affected
+	}
+	return nil
+}
+
+func (x *L8PollarisEvent) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *L8PollarisEvent) GetOldGroup() *L8PollarisGroupDefinition {
+	if x != nil {
+		return x.OldGroup
+	}
+	return nil
+}
+
+func (x *L8PollarisEvent) GetNewGroup() *L8PollarisGroupDefinition {
+	if x != nil {
+		return x.NewGroup
+	}
+	return nil
+}
+
+// L8PollarisDiff compares two pollaris definitions. As a request, either from
+// and to are set, or name with from_revision and to_revision, where a zero
+// to_revision means the current definition. The response carries the changes
+// and their text rendering.
+type L8PollarisDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the pollaris whose revisions are compared
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// from_revision is the revision compared from
+	FromRevision int64 `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	// to_revision is the revision compared to, zero for the current definition
+	ToRevision int64 `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	// from is the definition compared from
+	From *L8Pollaris `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the definition compared to
+	To *L8Pollaris `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// changes lists the differences found
+	Changes []*L8PChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	// text is the human readable rendering of the changes
+	Text string `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *L8PollarisDiff) Reset() {
+	*x = L8PollarisDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisDiff) ProtoMessage() {}
+
+func (x *L8PollarisDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisDiff.ProtoReflect.Descriptor instead.
+func (*L8PollarisDiff) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *L8PollarisDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PollarisDiff) GetFromRevision() int64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *L8PollarisDiff) GetToRevision() int64 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *L8PollarisDiff) GetFrom() *L8Pollaris {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *L8PollarisDiff) GetTo() *L8Pollaris {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *L8PollarisDiff) GetChanges() []*L8PChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *L8PollarisDiff) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// L8PChange is a single difference between two pollaris definitions.
+type L8PChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the kind of difference
+	Type L8PChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=l8tpollaris.L8PChangeType" json:"type,omitempty"`
+	// path locates the changed field, e.g. "polling[sysinfo].cadence.cadences"
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// old_value is the rendered value before the change
+	OldValue string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// new_value is the rendered value after the change
+	NewValue string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (x *L8PChange) Reset() {
+	*x = L8PChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PChange) ProtoMessage() {}
+
+func (x *L8PChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PChange.ProtoReflect.Descriptor instead.
+func (*L8PChange) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *L8PChange) GetType() L8PChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return L8PChangeType_L8PChange_Invalid
+}
+
+func (x *L8PChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *L8PChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *L8PChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// L8PollarisLookup looks up the pollaris of a device by key, like
+// PollarisByKey. As a request, name and the device attributes are set;
+// the response also carries the chosen effective pollaris.
+type L8PollarisLookup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the pollaris name to look up
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// vendor is the device manufacturer
+	Vendor string `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// series is the device product series
+	Series string `protobuf:"bytes,3,opt,name=series,proto3" json:"series,omitempty"`
+	// family is the device product family
+	Family string `protobuf:"bytes,4,opt,name=family,proto3" json:"family,omitempty"`
+	// software is the device software/OS type
+	Software string `protobuf:"bytes,5,opt,name=software,proto3" json:"software,omitempty"`
+	// hardware is the device hardware model
+	Hardware string `protobuf:"bytes,6,opt,name=hardware,proto3" json:"hardware,omitempty"`
+	// version is the device software version
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// result is the chosen effective pollaris
+	Result *L8Pollaris `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *L8PollarisLookup) Reset() {
+	*x = L8PollarisLookup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisLookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisLookup) ProtoMessage() {}
+
+func (x *L8PollarisLookup) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisLookup.ProtoReflect.Descriptor instead.
+func (*L8PollarisLookup) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *L8PollarisLookup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PollarisLookup) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *L8PollarisLookup) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *L8PollarisLookup) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *L8PollarisLookup) GetSoftware() string {
+	if x != nil {
+		return x.Software
+	}
+	return ""
+}
+
+func (x *L8PollarisLookup) GetHardware() string {
+	if x != nil {
+		return x.Hardware
+	}
+	return ""
+}
+
+func (x *L8PollarisLookup) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *L8PollarisLookup) GetResult() *L8Pollaris {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// L8PollarisGroup resolves the pollarises of a group for a device, like
+// PollsByGroup. As a request, group and the device attributes are set;
+// the response also carries the resolved pollarises.
+type L8PollarisGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group is the group name to resolve
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// vendor is the device manufacturer
+	Vendor string `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// series is the device product series
+	Series string `protobuf:"bytes,3,opt,name=series,proto3" json:"series,omitempty"`
+	// family is the device product family
+	Family string `protobuf:"bytes,4,opt,name=family,proto3" json:"family,omitempty"`
+	// software is the device software/OS type
+	Software string `protobuf:"bytes,5,opt,name=software,proto3" json:"software,omitempty"`
+	// hardware is the device hardware model
+	Hardware string `protobuf:"bytes,6,opt,name=hardware,proto3" json:"hardware,omitempty"`
+	// version is the device software version
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// list contains the resolved effective pollarises
+	List []*L8Pollaris `protobuf:"bytes,8,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *L8PollarisGroup) Reset() {
+	*x = L8PollarisGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisGroup) ProtoMessage() {}
+
+func (x *L8PollarisGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisGroup.ProtoReflect.Descriptor instead.
+func (*L8PollarisGroup) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *L8PollarisGroup) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *L8PollarisGroup) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *L8PollarisGroup) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *L8PollarisGroup) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *L8PollarisGroup) GetSoftware() string {
+	if x != nil {
+		return x.Software
+	}
+	return ""
+}
+
+func (x *L8PollarisGroup) GetHardware() string {
+	if x != nil {
+		return x.Hardware
+	}
+	return ""
+}
+
+func (x *L8PollarisGroup) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *L8PollarisGroup) GetList() []*L8Pollaris {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// L8PollarisGroupDefinition declares the groups a group includes, so the
+// group expands to the pollarises of the included groups as well. A
+// definition without includes removes the nesting of the group.
+type L8PollarisGroupDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group is the name of the including group
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// includes lists the names of the included groups
+	Includes []string `protobuf:"bytes,2,rep,name=includes,proto3" json:"includes,omitempty"`
+	// modified is the time of the last change in unix nanoseconds, used to
+	// order the definitions received from peers
+	Modified int64 `protobuf:"varint,3,opt,name=modified,proto3" json:"modified,omitempty"`
+	// origin is the uuid of the instance that made the last change
+	Origin string `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *L8PollarisGroupDefinition) Reset() {
+	*x = L8PollarisGroupDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisGroupDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisGroupDefinition) ProtoMessage() {}
+
+func (x *L8PollarisGroupDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisGroupDefinition.ProtoReflect.Descriptor instead.
+func (*L8PollarisGroupDefinition) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *L8PollarisGroupDefinition) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *L8PollarisGroupDefinition) GetIncludes() []string {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+func (x *L8PollarisGroupDefinition) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *L8PollarisGroupDefinition) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+// L8PollarisGroupTree is the resolved membership tree of a group. As a
+// request, only group is set; the response carries the members and the
+// trees of the included groups.
+type L8PollarisGroupTree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group is the group name
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// members lists the names of the pollarises directly in the group, sorted
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// groups are the trees of the included groups, in name order
+	Groups []*L8PollarisGroupTree `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *L8PollarisGroupTree) Reset() {
+	*x = L8PollarisGroupTree{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisGroupTree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisGroupTree) ProtoMessage() {}
+
+func (x *L8PollarisGroupTree) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisGroupTree.ProtoReflect.Descriptor instead.
+func (*L8PollarisGroupTree) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *L8PollarisGroupTree) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *L8PollarisGroupTree) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *L8PollarisGroupTree) GetGroups() []*L8PollarisGroupTree {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// L8PollarisGroups is the catalog of the groups of a center. As a request it
+// is empty, and the response carries the groups in name order.
+type L8PollarisGroups struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// groups describes each group
+	Groups []*L8PGroupInfo `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *L8PollarisGroups) Reset() {
+	*x = L8PollarisGroups{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisGroups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisGroups) ProtoMessage() {}
+
+func (x *L8PollarisGroups) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisGroups.ProtoReflect.Descriptor instead.
+func (*L8PollarisGroups) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *L8PollarisGroups) GetGroups() []*L8PGroupInfo {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// L8PGroupInfo describes a group in the group catalog.
+type L8PGroupInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group is the group name
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// members is the number of pollarises directly in the group
+	Members int32 `protobuf:"varint,2,opt,name=members,proto3" json:"members,omitempty"`
+	// includes lists the names of the groups the group includes, sorted
+	Includes []string `protobuf:"bytes,3,rep,name=includes,proto3" json:"includes,omitempty"`
+	// total is the number of pollarises the group expands to, with the
+	// members of the included groups
+	Total int32 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *L8PGroupInfo) Reset() {
+	*x = L8PGroupInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PGroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PGroupInfo) ProtoMessage() {}
+
+func (x *L8PGroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PGroupInfo.ProtoReflect.Descriptor instead.
+func (*L8PGroupInfo) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *L8PGroupInfo) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *L8PGroupInfo) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *L8PGroupInfo) GetIncludes() []string {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+func (x *L8PGroupInfo) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// L8PollarisIndex is the reverse index of the pollarises, from each pollaris
+// to its groups and keys. As a request, name may be set to index a single
+// pollaris; the response carries the entries in name order.
+type L8PollarisIndex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the pollaris to index, all of them when empty
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// entries lists the index entry of each pollaris
+	Entries []*L8PIndexEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *L8PollarisIndex) Reset() {
+	*x = L8PollarisIndex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisIndex) ProtoMessage() {}
+
+func (x *L8PollarisIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisIndex.ProtoReflect.Descriptor instead.
+func (*L8PollarisIndex) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *L8PollarisIndex) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PollarisIndex) GetEntries() []*L8PIndexEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// L8PIndexEntry lists the groups and keys of a pollaris.
+type L8PIndexEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the pollaris name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// groups lists the groups the pollaris is in, sorted
+	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	// keys lists the composite keys resolving to the pollaris, sorted
+	Keys []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *L8PIndexEntry) Reset() {
+	*x = L8PIndexEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PIndexEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PIndexEntry) ProtoMessage() {}
+
+func (x *L8PIndexEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PIndexEntry.ProtoReflect.Descriptor instead.
+func (*L8PIndexEntry) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *L8PIndexEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PIndexEntry) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *L8PIndexEntry) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// L8PollarisKeys is a snapshot of the key table of a center, for
+// troubleshooting key lookups. As a request it is empty, and the response
+// carries the entries in key order.
+type L8PollarisKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keys lists the entries of the key table
+	Keys []*L8PKeyEntry `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *L8PollarisKeys) Reset() {
+	*x = L8PollarisKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisKeys) ProtoMessage() {}
+
+func (x *L8PollarisKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisKeys.ProtoReflect.Descriptor instead.
+func (*L8PollarisKeys) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *L8PollarisKeys) GetKeys() []*L8PKeyEntry {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// L8PKeyEntry is an entry of the key table.
+type L8PKeyEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key is the composite key, the name followed by the non-empty vendor,
+	// series, family, software, hardware and version, separated by "+"
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// name is the name of the pollaris the key resolves to
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *L8PKeyEntry) Reset() {
+	*x = L8PKeyEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PKeyEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PKeyEntry) ProtoMessage() {}
+
+func (x *L8PKeyEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PKeyEntry.ProtoReflect.Descriptor instead.
+func (*L8PKeyEntry) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *L8PKeyEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *L8PKeyEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// L8PollarisPoll fetches a single poll of a pollaris, like Poll. As a
+// request, the pollaris and poll names are set; the response carries the poll.
+type L8PollarisPoll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pollaris_name is the name of the pollaris holding the poll
+	PollarisName string `protobuf:"bytes,1,opt,name=pollaris_name,json=pollarisName,proto3" json:"pollaris_name,omitempty"`
+	// poll_name is the name of the poll
+	PollName string `protobuf:"bytes,2,opt,name=poll_name,json=pollName,proto3" json:"poll_name,omitempty"`
+	// poll is the fetched poll
+	Poll *L8Poll `protobuf:"bytes,3,opt,name=poll,proto3" json:"poll,omitempty"`
+}
+
+func (x *L8PollarisPoll) Reset() {
+	*x = L8PollarisPoll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisPoll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisPoll) ProtoMessage() {}
+
+func (x *L8PollarisPoll) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisPoll.ProtoReflect.Descriptor instead.
+func (*L8PollarisPoll) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *L8PollarisPoll) GetPollarisName() string {
+	if x != nil {
+		return x.PollarisName
+	}
+	return ""
+}
+
+func (x *L8PollarisPoll) GetPollName() string {
+	if x != nil {
+		return x.PollName
+	}
+	return ""
+}
+
+func (x *L8PollarisPoll) GetPoll() *L8Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+// L8PollarisDryRun runs the polls of a pollaris against recorded device
+// responses, without devices, and reports the resulting property values.
+// As a request, either pollaris or name is set along with the responses;
+// the response also carries the results.
+type L8PollarisDryRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pollaris is the model to run
+	Pollaris *L8Pollaris `protobuf:"bytes,1,opt,name=pollaris,proto3" json:"pollaris,omitempty"`
+	// name is the stored pollaris to run, with inheritance resolved, when
+	// pollaris is not set
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// responses are the recorded device responses
+	Responses []*L8PRecordedResponse `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses,omitempty"`
+	// results lists the outcome of each poll, in poll name order
+	Results []*L8PDryRunPoll `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *L8PollarisDryRun) Reset() {
+	*x = L8PollarisDryRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PollarisDryRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PollarisDryRun) ProtoMessage() {}
+
+func (x *L8PollarisDryRun) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PollarisDryRun.ProtoReflect.Descriptor instead.
+func (*L8PollarisDryRun) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *L8PollarisDryRun) GetPollaris() *L8Pollaris {
+	if x != nil {
+		return x.Pollaris
+	}
+	return nil
+}
+
+func (x *L8PollarisDryRun) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PollarisDryRun) GetResponses() []*L8PRecordedResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *L8PollarisDryRun) GetResults() []*L8PDryRunPoll {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// L8PRecordedResponse is a device response recorded for a dry run.
+type L8PRecordedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// protocol is the protocol of the response, unset to match any protocol
+	Protocol L8PProtocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=l8tpollaris.L8PProtocol" json:"protocol,omitempty"`
+	// what is the What of the polls the response answers
+	What string `protobuf:"bytes,2,opt,name=what,proto3" json:"what,omitempty"`
+	// data is the response as collected from the device
+	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *L8PRecordedResponse) Reset() {
+	*x = L8PRecordedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PRecordedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PRecordedResponse) ProtoMessage() {}
+
+func (x *L8PRecordedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PRecordedResponse.ProtoReflect.Descriptor instead.
+func (*L8PRecordedResponse) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *L8PRecordedResponse) GetProtocol() L8PProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return L8PProtocol_L8PInvalid_Protocol
+}
+
+func (x *L8PRecordedResponse) GetWhat() string {
+	if x != nil {
+		return x.What
+	}
+	return ""
+}
+
+func (x *L8PRecordedResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+// L8PDryRunPoll is the outcome of running a single poll in a dry run.
+type L8PDryRunPoll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// poll_name is the name of the poll
+	PollName string `protobuf:"bytes,1,opt,name=poll_name,json=pollName,proto3" json:"poll_name,omitempty"`
+	// protocol is the protocol of the poll
+	Protocol L8PProtocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=l8tpollaris.L8PProtocol" json:"protocol,omitempty"`
+	// what is the What of the poll
+	What string `protobuf:"bytes,3,opt,name=what,proto3" json:"what,omitempty"`
+	// no_response indicates there is no recorded response for the poll
+	NoResponse bool `protobuf:"varint,4,opt,name=no_response,json=noResponse,proto3" json:"no_response,omitempty"`
+	// values lists the property values produced by the poll attributes
+	Values []*L8PDryRunValue `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *L8PDryRunPoll) Reset() {
+	*x = L8PDryRunPoll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PDryRunPoll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PDryRunPoll) ProtoMessage() {}
+
+func (x *L8PDryRunPoll) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PDryRunPoll.ProtoReflect.Descriptor instead.
+func (*L8PDryRunPoll) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *L8PDryRunPoll) GetPollName() string {
+	if x != nil {
+		return x.PollName
+	}
+	return ""
+}
+
+func (x *L8PDryRunPoll) GetProtocol() L8PProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return L8PProtocol_L8PInvalid_Protocol
+}
+
+func (x *L8PDryRunPoll) GetWhat() string {
+	if x != nil {
+		return x.What
+	}
+	return ""
+}
+
+func (x *L8PDryRunPoll) GetNoResponse() bool {
+	if x != nil {
+		return x.NoResponse
+	}
+	return false
+}
+
+func (x *L8PDryRunPoll) GetValues() []*L8PDryRunValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// L8PDryRunValue is the value an attribute produced in a dry run.
+type L8PDryRunValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// property_id identifies the property of the attribute
+	PropertyId string `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// value is the resulting property value, empty on failure
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// error describes the rule failure, empty on success
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *L8PDryRunValue) Reset() {
+	*x = L8PDryRunValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PDryRunValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PDryRunValue) ProtoMessage() {}
+
+func (x *L8PDryRunValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PDryRunValue.ProtoReflect.Descriptor instead.
+func (*L8PDryRunValue) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *L8PDryRunValue) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *L8PDryRunValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *L8PDryRunValue) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// L8PAuditRecord records a change made to a pollaris or a target.
+type L8PAuditRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id uniquely identifies the record
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// actor is the identity that made the change, anonymous if unknown
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// timestamp is the time of the change in unix nanoseconds
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// operation is the operation that made the change, such as POST or DELETE
+	Operation string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	// object_type is the type of the changed object, such as L8Pollaris
+	ObjectType string `protobuf:"bytes,5,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	// object_id is the identity of the changed object, such as the pollaris name
+	ObjectId string `protobuf:"bytes,6,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// changes is the diff from the object before the change to after it
+	Changes []*L8PChange `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *L8PAuditRecord) Reset() {
+	*x = L8PAuditRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PAuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PAuditRecord) ProtoMessage() {}
+
+func (x *L8PAuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PAuditRecord.ProtoReflect.Descriptor instead.
+func (*L8PAuditRecord) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *L8PAuditRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *L8PAuditRecord) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *L8PAuditRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *L8PAuditRecord) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *L8PAuditRecord) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *L8PAuditRecord) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *L8PAuditRecord) GetChanges() []*L8PChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// L8PAuditRecordList contains a list of audit records.
+type L8PAuditRecordList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list contains the audit records
+	List []*L8PAuditRecord `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *L8PAuditRecordList) Reset() {
+	*x = L8PAuditRecordList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PAuditRecordList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PAuditRecordList) ProtoMessage() {}
+
+func (x *L8PAuditRecordList) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PAuditRecordList.ProtoReflect.Descriptor instead.
+func (*L8PAuditRecordList) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *L8PAuditRecordList) GetList() []*L8PAuditRecord {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// L8PAuditQuery queries the audit trail. As a request, the filters are set,
+// where empty or zero filters match all records; the response also carries
+// the matching records, oldest first.
+type L8PAuditQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// object_type filters by the type of the changed object
+	ObjectType string `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	// object_id filters by the identity of the changed object
+	ObjectId string `protobuf:"bytes,2,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// actor filters by the identity that made the change
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// from filters out the records before this unix nanoseconds time
+	From int64 `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	// to filters out the records after this unix nanoseconds time
+	To int64 `protobuf:"varint,5,opt,name=to,proto3" json:"to,omitempty"`
+	// limit is the maximum number of records to return, 0 for all
+	Limit int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// page is the page of records to return, of limit records each
+	Page int32 `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	// records contains the matching records
+	Records []*L8PAuditRecord `protobuf:"bytes,8,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *L8PAuditQuery) Reset() {
+	*x = L8PAuditQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PAuditQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PAuditQuery) ProtoMessage() {}
+
+func (x *L8PAuditQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PAuditQuery.ProtoReflect.Descriptor instead.
+func (*L8PAuditQuery) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *L8PAuditQuery) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *L8PAuditQuery) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *L8PAuditQuery) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *L8PAuditQuery) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *L8PAuditQuery) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *L8PAuditQuery) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *L8PAuditQuery) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *L8PAuditQuery) GetRecords() []*L8PAuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// L8Poll defines a single polling job configuration.
+// It specifies what data to collect, how to collect it, and how often.
+type L8Poll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the identifier for this polling job
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// what specifies the data to collect (e.g., OID for SNMP, command for SSH)
+	What string `protobuf:"bytes,2,opt,name=what,proto3" json:"what,omitempty"`
+	// operation defines the type of collection (Get, Map, Table)
+	Operation L8C_Operation `protobuf:"varint,3,opt,name=operation,proto3,enum=l8tpollaris.L8C_Operation" json:"operation,omitempty"`
+	// protocol specifies which protocol to use for collection
+	Protocol L8PProtocol `protobuf:"varint,4,opt,name=protocol,proto3,enum=l8tpollaris.L8PProtocol" json:"protocol,omitempty"`
+	// cadence defines the polling schedule
+	Cadence *L8PCadencePlan `protobuf:"bytes,5,opt,name=cadence,proto3" json:"cadence,omitempty"`
+	// timeout is the maximum wait time in milliseconds
+	Timeout int64 `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// attributes define parsing rules for the collected data
+	Attributes []*L8PAttribute `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// bodyName specifies the request body template name
+	BodyName string `protobuf:"bytes,8,opt,name=bodyName,proto3" json:"bodyName,omitempty"`
+	// respName specifies the response parser name
+	RespName string `protobuf:"bytes,9,opt,name=respName,proto3" json:"respName,omitempty"`
+	// always indicates if this job should run on every collection cycle
+	Always bool `protobuf:"varint,10,opt,name=always,proto3" json:"always,omitempty"`
+	// depends_on lists the names of the polls, in the same pollaris, that
+	// must run before this one
+	DependsOn []string `protobuf:"bytes,11,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// condition is an optional predicate on the target, host and prior
+	// results that must hold for the poll to run, e.g.
+	// "host.protocols contains 'NETCONF' and target.inventoryType = 'Storage'"
+	Condition string `protobuf:"bytes,12,opt,name=condition,proto3" json:"condition,omitempty"`
+}
+
+func (x *L8Poll) Reset() {
+	*x = L8Poll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8Poll) ProtoMessage() {}
+
+func (x *L8Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8Poll.ProtoReflect.Descriptor instead.
+func (*L8Poll) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *L8Poll) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8Poll) GetWhat() string {
+	if x != nil {
+		return x.What
+	}
+	return ""
+}
+
+func (x *L8Poll) GetOperation() L8C_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return L8C_Operation_Invalid_Operation
+}
+
+func (x *L8Poll) GetProtocol() L8PProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return L8PProtocol_L8PInvalid_Protocol
+}
+
+func (x *L8Poll) GetCadence() *L8PCadencePlan {
+	if x != nil {
+		return x.Cadence
+	}
+	return nil
+}
+
+func (x *L8Poll) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *L8Poll) GetAttributes() []*L8PAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *L8Poll) GetBodyName() string {
+	if x != nil {
+		return x.BodyName
+	}
+	return ""
+}
+
+func (x *L8Poll) GetRespName() string {
+	if x != nil {
+		return x.RespName
+	}
+	return ""
+}
+
+func (x *L8Poll) GetAlways() bool {
+	if x != nil {
+		return x.Always
+	}
+	return false
+}
+
+func (x *L8Poll) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *L8Poll) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+// L8PAttribute defines a parsed attribute from collected data.
+type L8PAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// property_id identifies the property this attribute maps to
+	PropertyId string `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// rules define parsing/transformation rules for this attribute
+	Rules []*L8PRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *L8PAttribute) Reset() {
+	*x = L8PAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PAttribute) ProtoMessage() {}
+
+func (x *L8PAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PAttribute.ProtoReflect.Descriptor instead.
+func (*L8PAttribute) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *L8PAttribute) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *L8PAttribute) GetRules() []*L8PRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// L8PRule defines a parsing or transformation rule.
+type L8PRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name identifies the rule type
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// params contains rule-specific parameters
+	Params map[string]*L8PParameter `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *L8PRule) Reset() {
+	*x = L8PRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PRule) ProtoMessage() {}
+
+func (x *L8PRule) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PRule.ProtoReflect.Descriptor instead.
+func (*L8PRule) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *L8PRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PRule) GetParams() map[string]*L8PParameter {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// L8PParameter represents a named parameter with a string value.
+type L8PParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the parameter identifier
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// value is the parameter value
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *L8PParameter) Reset() {
+	*x = L8PParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PParameter) ProtoMessage() {}
+
+func (x *L8PParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PParameter.ProtoReflect.Descriptor instead.
+func (*L8PParameter) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *L8PParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8PParameter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// L8PCadencePlan defines the polling schedule with adaptive cadences.
+// It supports multiple cadence levels that can be used based on conditions.
+type L8PCadencePlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cadences is a list of polling intervals in milliseconds
+	Cadences []int64 `protobuf:"varint,1,rep,packed,name=cadences,proto3" json:"cadences,omitempty"`
+	// startups defines initial delays before polling starts
+	Startups []int64 `protobuf:"varint,2,rep,packed,name=startups,proto3" json:"startups,omitempty"`
+	// current is the index of the currently active cadence
+	Current int32 `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	// enabled indicates if this cadence plan is active
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *L8PCadencePlan) Reset() {
+	*x = L8PCadencePlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pollaris_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8PCadencePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8PCadencePlan) ProtoMessage() {}
+
+func (x *L8PCadencePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_pollaris_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8PCadencePlan.ProtoReflect.Descriptor instead.
+func (*L8PCadencePlan) Descriptor() ([]byte, []int) {
+	return file_pollaris_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *L8PCadencePlan) GetCadences() []int64 {
+	if x != nil {
+		return x.Cadences
+	}
+	return nil
+}
+
+func (x *L8PCadencePlan) GetStartups() []int64 {
+	if x != nil {
+		return x.Startups
+	}
+	return nil
+}
+
+func (x *L8PCadencePlan) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *L8PCadencePlan) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+var File_pollaris_proto protoreflect.FileDescriptor
+
+var file_pollaris_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x1a, 0x09, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x04, 0x0a, 0x0a, 0x4c, 0x38, 0x50,
+	0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x3e, 0x0a,
+	0x07, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50,
+	0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x1a, 0x4f, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x4c, 0x38, 0x50, 0x6f, 0x6c,
+	0x6c, 0x61, 0x72, 0x69, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2b, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38,
+	0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x69, 0x73, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x38, 0x74,
+	0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61,
+	0x72, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x60, 0x0a, 0x13, 0x4c, 0x38,
+	0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x12,
+	0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x0e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73,
+	0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x38, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x38, 0x4d, 0x65,
+	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xf4, 0x02, 0x0a, 0x11, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x65, 0x64, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x69, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6b, 0x0a, 0x13, 0x4c, 0x38, 0x50, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x10, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61,
+	0x72, 0x69, 0x73, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38,
+	0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x69, 0x73, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x38, 0x74,
+	0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61,
+	0x72, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x7b, 0x0a, 0x10, 0x4c, 0x38,
+	0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73,
+	0x12, 0x35, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c,
+	0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x10, 0x4c, 0x38, 0x50, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x06,
+	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c,
+	0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c,
+	0x6c, 0x61, 0x72, 0x69, 0x73, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x2e, 0x4c, 0x38, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c,
+	0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0f, 0x4c, 0x38, 0x50, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x41, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xae,
+	0x01, 0x0a, 0x16, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x22,
+	0xe6, 0x02, 0x0a, 0x0f, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e,
+	0x4c, 0x38, 0x50, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52, 0x03, 0x6f, 0x6c,
+	0x64, 0x12, 0x29, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50,
+	0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x43,
+	0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e,
+	0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x6e, 0x65, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x86, 0x02, 0x0a, 0x0e, 0x4c, 0x38, 0x50,
+	0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x27, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50,
+	0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x30, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c,
+	0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x4c, 0x38, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf1, 0x01,
+	0x0a, 0x10, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61,
+	0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61,
+	0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c,
+	0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0xee, 0x01, 0x0a, 0x0f, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x7f, 0x0a, 0x13, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x72, 0x65, 0x65, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x45, 0x0a, 0x10, 0x4c, 0x38, 0x50, 0x6f, 0x6c,
+	0x6c, 0x61, 0x72, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x38,
+	0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x70,
+	0x0a, 0x0c, 0x4c, 0x38, 0x50, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0x5b, 0x0a, 0x0f, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x4f, 0x0a,
+	0x0d, 0x4c, 0x38, 0x50, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x3e,
+	0x0a, 0x0e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50,
+	0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x33,
+	0x0a, 0x0b, 0x4c, 0x38, 0x50, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x7b, 0x0a, 0x0e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f,
+	0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6f, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61,
+	0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c,
+	0x22, 0xd1, 0x01, 0x0a, 0x10, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c,
+	0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73,
+	0x52, 0x08, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e,
+	0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e,
+	0x4c, 0x38, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x34,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38,
+	0x50, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x73, 0x0a, 0x13, 0x4c, 0x38, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x77, 0x68, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcc, 0x01, 0x0a, 0x0d, 0x4c, 0x38,
+	0x50, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6f, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x38, 0x74,
+	0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x77, 0x68, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x68,
+	0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69,
+	0x73, 0x2e, 0x4c, 0x38, 0x50, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x0e, 0x4c, 0x38, 0x50, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe2, 0x01, 0x0a, 0x0e, 0x4c, 0x38, 0x50, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x38,
+	0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x12,
+	0x4c, 0x38, 0x50, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c,
+	0x38, 0x50, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x0d, 0x4c, 0x38, 0x50, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xb9,
+	0x03, 0x0a, 0x06, 0x4c, 0x38, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x68, 0x61,
+	0x74, 0x12, 0x38, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72,
+	0x69, 0x73, 0x2e, 0x4c, 0x38, 0x43, 0x5f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73,
+	0x2e, 0x4c, 0x38, 0x50, 0x43, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x62, 0x6f, 0x64, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x62, 0x6f, 0x64, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x0c, 0x4c, 0x38,
+	0x50, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x38, 0x74,
+	0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x07, 0x4c, 0x38, 0x50, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c,
+	0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x4c, 0x38, 0x50, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e,
+	0x4c, 0x38, 0x50, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x0c, 0x4c, 0x38, 0x50, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x4c, 0x38, 0x50, 0x43, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x2a,
+	0x5c, 0x0a, 0x11, 0x4c, 0x38, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x38, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x5f, 0x53, 0x6b, 0x69, 0x70, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x38,
+	0x50, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x38, 0x50, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x5f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0x02, 0x2a, 0xb5, 0x01,
+	0x0a, 0x0f, 0x4c, 0x38, 0x50, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x38, 0x50, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x38, 0x50, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x41, 0x64, 0x64, 0x65, 0x64, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x4c, 0x38, 0x50, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x53, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x38, 0x50, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x10, 0x03, 0x12,
+	0x15, 0x0a, 0x11, 0x4c, 0x38, 0x50, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x64, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x38, 0x50, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x55, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10, 0x05, 0x12,
+	0x14, 0x0a, 0x10, 0x4c, 0x38, 0x50, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x06, 0x2a, 0x64, 0x0a, 0x0c, 0x4c, 0x38, 0x50, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x38, 0x50, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c,
+	0x38, 0x50, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x41, 0x64, 0x64, 0x65, 0x64, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x4c, 0x38, 0x50, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x38, 0x50, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x03, 0x2a, 0x69, 0x0a, 0x0d, 0x4c,
+	0x38, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11,
+	0x4c, 0x38, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x38, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x41, 0x64, 0x64, 0x65, 0x64, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x38, 0x50, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x4c, 0x38, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x10, 0x03, 0x2a, 0x4f, 0x0a, 0x0d, 0x4c, 0x38, 0x43, 0x5f, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x4c, 0x38, 0x43, 0x5f, 0x47, 0x65, 0x74, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4c,
+	0x38, 0x43, 0x5f, 0x4d, 0x61, 0x70, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x38, 0x43, 0x5f,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x03, 0x2a, 0x9f, 0x01, 0x0a, 0x0b, 0x4c, 0x38, 0x50, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x38, 0x50, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x38, 0x50, 0x53, 0x53, 0x48, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4c, 0x38, 0x50, 0x50, 0x53, 0x4e, 0x4d, 0x50, 0x56, 0x32, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x4c, 0x38, 0x50, 0x53, 0x4e, 0x4d, 0x50, 0x56, 0x33, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4c,
+	0x38, 0x50, 0x52, 0x45, 0x53, 0x54, 0x43, 0x4f, 0x4e, 0x46, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4c, 0x38, 0x50, 0x4e, 0x45, 0x54, 0x43, 0x4f, 0x4e, 0x46, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07,
+	0x4c, 0x38, 0x50, 0x47, 0x52, 0x50, 0x43, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x38, 0x50,
+	0x4b, 0x75, 0x62, 0x65, 0x63, 0x74, 0x6c, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x38, 0x50,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x10, 0x08, 0x42, 0x3b, 0x0a, 0x15, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6c, 0x38, 0x74, 0x70, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x42, 0x0b, 0x4c, 0x38, 0x54, 0x50, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x50,
+	0x01, 0x5a, 0x13, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6c, 0x38, 0x74, 0x70, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pollaris_proto_rawDescOnce sync.Once
+	file_pollaris_proto_rawDescData = file_pollaris_proto_rawDesc
+)
+
+func file_pollaris_proto_rawDescGZIP() []byte {
+	file_pollaris_proto_rawDescOnce.Do(func() {
+		file_pollaris_proto_rawDescData = protoimpl.X.CompressGZIP(file_pollaris_proto_rawDescData)
+	})
+	return file_pollaris_proto_rawDescData
+}
+
+var file_pollaris_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_pollaris_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_pollaris_proto_goTypes = []interface{}{
+	(L8PConflictPolicy)(0),            // 0: l8tpollaris.L8PConflictPolicy
+	(L8PImportStatus)(0),              // 1: l8tpollaris.L8PImportStatus
+	(L8PEventType)(0),                 // 2: l8tpollaris.L8PEventType
+	(L8PChangeType)(0),                // 3: l8tpollaris.L8PChangeType
+	(L8C_Operation)(0),                // 4: l8tpollaris.L8C_Operation
+	(L8PProtocol)(0),                  // 5: l8tpollaris.L8PProtocol
+	(*L8Pollaris)(nil),                // 6: l8tpollaris.L8Pollaris
+	(*L8PollarisSnapshot)(nil),        // 7: l8tpollaris.L8PollarisSnapshot
+	(*L8PollarisRevisions)(nil),       // 8: l8tpollaris.L8PollarisRevisions
+	(*L8PollarisRollback)(nil),        // 9: l8tpollaris.L8PollarisRollback
+	(*L8PollarisList)(nil),            // 10: l8tpollaris.L8PollarisList
+	(*L8PollarisExplain)(nil),         // 11: l8tpollaris.L8PollarisExplain
+	(*L8PExplainCandidate)(nil),       // 12: l8tpollaris.L8PExplainCandidate
+	(*L8PollarisBundle)(nil),          // 13: l8tpollaris.L8PollarisBundle
+	(*L8PollarisExport)(nil),          // 14: l8tpollaris.L8PollarisExport
+	(*L8PollarisImport)(nil),          // 15: l8tpollaris.L8PollarisImport
+	(*L8PImportResult)(nil),           // 16: l8tpollaris.L8PImportResult
+	(*L8PollarisSubscription)(nil),    // 17: l8tpollaris.L8PollarisSubscription
+	(*L8PollarisEvent)(nil),           // 18: l8tpollaris.L8PollarisEvent
+	(*L8PollarisDiff)(nil),            // 19: l8tpollaris.L8PollarisDiff
+	(*L8PChange)(nil),                 // 20: l8tpollaris.L8PChange
+	(*L8PollarisLookup)(nil),          // 21: l8tpollaris.L8PollarisLookup
+	(*L8PollarisGroup)(nil),           // 22: l8tpollaris.L8PollarisGroup
+	(*L8PollarisGroupDefinition)(nil), // 23: l8tpollaris.L8PollarisGroupDefinition
+	(*L8PollarisGroupTree)(nil),       // 24: l8tpollaris.L8PollarisGroupTree
+	(*L8PollarisGroups)(nil),          // 25: l8tpollaris.L8PollarisGroups
+	(*L8PGroupInfo)(nil),              // 26: l8tpollaris.L8PGroupInfo
+	(*L8PollarisIndex)(nil),           // 27: l8tpollaris.L8PollarisIndex
+	(*L8PIndexEntry)(nil),             // 28: l8tpollaris.L8PIndexEntry
+	(*L8PollarisKeys)(nil),            // 29: l8tpollaris.L8PollarisKeys
+	(*L8PKeyEntry)(nil),               // 30: l8tpollaris.L8PKeyEntry
+	(*L8PollarisPoll)(nil),            // 31: l8tpollaris.L8PollarisPoll
+	(*L8PollarisDryRun)(nil),          // 32: l8tpollaris.L8PollarisDryRun
+	(*L8PRecordedResponse)(nil),       // 33: l8tpollaris.L8PRecordedResponse
+	(*L8PDryRunPoll)(nil),             // 34: l8tpollaris.L8PDryRunPoll
+	(*L8PDryRunValue)(nil),            // 35: l8tpollaris.L8PDryRunValue
+	(*L8PAuditRecord)(nil),            // 36: l8tpollaris.L8PAuditRecord
+	(*L8PAuditRecordList)(nil),        // 37: l8tpollaris.L8PAuditRecordList
+	(*L8PAuditQuery)(nil),             // 38: l8tpollaris.L8PAuditQuery
+	(*L8Poll)(nil),                    // 39: l8tpollaris.L8Poll
+	(*L8PAttribute)(nil),              // 40: l8tpollaris.L8PAttribute
+	(*L8PRule)(nil),                   // 41: l8tpollaris.L8PRule
+	(*L8PParameter)(nil),              // 42: l8tpollaris.L8PParameter
+	(*L8PCadencePlan)(nil),            // 43: l8tpollaris.L8PCadencePlan
+	nil,                               // 44: l8tpollaris.L8Pollaris.PollingEntry
+	nil,                               // 45: l8tpollaris.L8PRule.ParamsEntry
+	(*l8api.L8MetaData)(nil),          // 46: l8api.L8MetaData
+}
+var file_pollaris_proto_depIdxs = []int32{
+	44, // 0: l8tpollaris.L8Pollaris.polling:type_name -> l8tpollaris.L8Pollaris.PollingEntry
+	6,  // 1: l8tpollaris.L8PollarisSnapshot.list:type_name -> l8tpollaris.L8Pollaris
+	23, // 2: l8tpollaris.L8PollarisSnapshot.groups:type_name -> l8tpollaris.L8PollarisGroupDefinition
+	6,  // 3: l8tpollaris.L8PollarisRevisions.revisions:type_name -> l8tpollaris.L8Pollaris
+	6,  // 4: l8tpollaris.L8PollarisList.list:type_name -> l8tpollaris.L8Pollaris
+	46, // 5: l8tpollaris.L8PollarisList.metadata:type_name -> l8api.L8MetaData
+	12, // 6: l8tpollaris.L8PollarisExplain.candidates:type_name -> l8tpollaris.L8PExplainCandidate
+	6,  // 7: l8tpollaris.L8PollarisExplain.result:type_name -> l8tpollaris.L8Pollaris
+	6,  // 8: l8tpollaris.L8PollarisBundle.list:type_name -> l8tpollaris.L8Pollaris
+	23, // 9: l8tpollaris.L8PollarisBundle.groups:type_name -> l8tpollaris.L8PollarisGroupDefinition
+	13, // 10: l8tpollaris.L8PollarisExport.bundle:type_name -> l8tpollaris.L8PollarisBundle
+	13, // 11: l8tpollaris.L8PollarisImport.bundle:type_name -> l8tpollaris.L8PollarisBundle
+	0,  // 12: l8tpollaris.L8PollarisImport.policy:type_name -> l8tpollaris.L8PConflictPolicy
+	16, // 13: l8tpollaris.L8PollarisImport.results:type_name -> l8tpollaris.L8PImportResult
+	1,  // 14: l8tpollaris.L8PImportResult.status:type_name -> l8tpollaris.L8PImportStatus
+	2,  // 15: l8tpollaris.L8PollarisEvent.type:type_name -> l8tpollaris.L8PEventType
+	6,  // 16: l8tpollaris.L8PollarisEvent.old:type_name -> l8tpollaris.L8Pollaris
+	6,  // 17: l8tpollaris.L8PollarisEvent.new:type_name -> l8tpollaris.L8Pollaris
+	23, // 18: l8tpollaris.L8PollarisEvent.old_group:type_name -> l8tpollaris.L8PollarisGroupDefinition
+	23, // 19: l8tpollaris.L8PollarisEvent.new_group:type_name -> l8tpollaris.L8PollarisGroupDefinition
+	6,  // 20: l8tpollaris.L8PollarisDiff.from:type_name -> l8tpollaris.L8Pollaris
+	6,  // 21: l8tpollaris.L8PollarisDiff.to:type_name -> l8tpollaris.L8Pollaris
+	20, // 22: l8tpollaris.L8PollarisDiff.changes:type_name -> l8tpollaris.L8PChange
+	3,  // 23: l8tpollaris.L8PChange.type:type_name -> l8tpollaris.L8PChangeType
+	6,  // 24: l8tpollaris.L8PollarisLookup.result:type_name -> l8tpollaris.L8Pollaris
+	6,  // 25: l8tpollaris.L8PollarisGroup.list:type_name -> l8tpollaris.L8Pollaris
+	24, // 26: l8tpollaris.L8PollarisGroupTree.groups:type_name -> l8tpollaris.L8PollarisGroupTree
+	26, // 27: l8tpollaris.L8PollarisGroups.groups:type_name -> l8tpollaris.L8PGroupInfo
+	28, // 28: l8tpollaris.L8PollarisIndex.entries:type_name -> l8tpollaris.L8PIndexEntry
+	30, // 29: l8tpollaris.L8PollarisKeys.keys:type_name -> l8tpollaris.L8PKeyEntry
+	39, // 30: l8tpollaris.L8PollarisPoll.poll:type_name -> l8tpollaris.L8Poll
+	6,  // 31: l8tpollaris.L8PollarisDryRun.pollaris:type_name -> l8tpollaris.L8Pollaris
+	33, // 32: l8tpollaris.L8PollarisDryRun.responses:type_name -> l8tpollaris.L8PRecordedResponse
+	34, // 33: l8tpollaris.L8PollarisDryRun.results:type_name -> l8tpollaris.L8PDryRunPoll
+	5,  // 34: l8tpollaris.L8PRecordedResponse.protocol:type_name -> l8tpollaris.L8PProtocol
+	5,  // 35: l8tpollaris.L8PDryRunPoll.protocol:type_name -> l8tpollaris.L8PProtocol
+	35, // 36: l8tpollaris.L8PDryRunPoll.values:type_name -> l8tpollaris.L8PDryRunValue
+	20, // 37: l8tpollaris.L8PAuditRecord.changes:type_name -> l8tpollaris.L8PChange
+	36, // 38: l8tpollaris.L8PAuditRecordList.list:type_name -> l8tpollaris.L8PAuditRecord
+	36, // 39: l8tpollaris.L8PAuditQuery.records:type_name -> l8tpollaris.L8PAuditRecord
+	4,  // 40: l8tpollaris.L8Poll.operation:type_name -> l8tpollaris.L8C_Operation
+	5,  // 41: l8tpollaris.L8Poll.protocol:type_name -> l8tpollaris.L8PProtocol
+	43, // 42: l8tpollaris.L8Poll.cadence:type_name -> l8tpollaris.L8PCadencePlan
+	40, // 43: l8tpollaris.L8Poll.attributes:type_name -> l8tpollaris.L8PAttribute
+	41, // 44: l8tpollaris.L8PAttribute.rules:type_name -> l8tpollaris.L8PRule
+	45, // 45: l8tpollaris.L8PRule.params:type_name -> l8tpollaris.L8PRule.ParamsEntry
+	39, // 46: l8tpollaris.L8Pollaris.PollingEntry.value:type_name -> l8tpollaris.L8Poll
+	42, // 47: l8tpollaris.L8PRule.ParamsEntry.value:type_name -> l8tpollaris.L8PParameter
+	48, // [48:48] is the sub-list for method output_type
+	48, // [48:48] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
+}
+
+func init() { file_pollaris_proto_init() }
+func file_pollaris_proto_init() {
+	if File_pollaris_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pollaris_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8Pollaris); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisRevisions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisRollback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisExplain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PExplainCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_pollaris_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_pollaris_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PImportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisLookup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisGroupDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisGroupTree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisGroups); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PGroupInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PIndexEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PKeyEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisPoll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PollarisDryRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PRecordedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PDryRunPoll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PDryRunValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PAuditRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PAuditRecordList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8PAuditQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8Poll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pollaris_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*L8PAttribute); i {
 			case 0:
 				return &v.state
@@ -862,7 +4525,7 @@ func file_pollaris_proto_init() {
 				return nil
 			}
 		}
-		file_pollaris_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_pollaris_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*L8PRule); i {
 			case 0:
 				return &v.state
@@ -874,7 +4537,7 @@ func file_pollaris_proto_init() {
 				return nil
 			}
 		}
-		file_pollaris_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_pollaris_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*L8PParameter); i {
 			case 0:
 				return &v.state
@@ -886,7 +4549,7 @@ func file_pollaris_proto_init() {
 				return nil
 			}
 		}
-		file_pollaris_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_pollaris_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*L8PCadencePlan); i {
 			case 0:
 				return &v.state
@@ -904,8 +4567,8 @@ func file_pollaris_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pollaris_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      6,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   0,
 		},